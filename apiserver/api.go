@@ -21,7 +21,9 @@ type ConfigurationAPIRouter interface {
 	DeleteConfigurationById(http.ResponseWriter, *http.Request)
 	GetConfigurationById(http.ResponseWriter, *http.Request)
 	GetConfigurations(http.ResponseWriter, *http.Request)
+	PatchConfigurationById(http.ResponseWriter, *http.Request)
 	PostConfiguration(http.ResponseWriter, *http.Request)
+	PostFilterPreview(http.ResponseWriter, *http.Request)
 	PutConfigurationById(http.ResponseWriter, *http.Request)
 }
 
@@ -30,6 +32,7 @@ type ConfigurationAPIRouter interface {
 // pass the data to a CustomizationAPIServicer to perform the required actions, then write the service results to the http response.
 type CustomizationAPIRouter interface {
 	GetDashboardTemplateByName(http.ResponseWriter, *http.Request)
+	GetDashboardTemplates(http.ResponseWriter, *http.Request)
 }
 
 // VersionAPIRouter defines the required methods for binding the api requests to a responses for the VersionAPI
@@ -48,7 +51,9 @@ type ConfigurationAPIServicer interface {
 	DeleteConfigurationById(context.Context, int64) (ImplResponse, error)
 	GetConfigurationById(context.Context, int64) (ImplResponse, error)
 	GetConfigurations(context.Context) (ImplResponse, error)
+	PatchConfigurationById(context.Context, int64, map[string]interface{}) (ImplResponse, error)
 	PostConfiguration(context.Context, Configuration) (ImplResponse, error)
+	PostFilterPreview(context.Context, int64, [][]FilterRule) (ImplResponse, error)
 	PutConfigurationById(context.Context, int64, Configuration) (ImplResponse, error)
 }
 
@@ -58,6 +63,7 @@ type ConfigurationAPIServicer interface {
 // and updated with the logic required for the API.
 type CustomizationAPIServicer interface {
 	GetDashboardTemplateByName(context.Context, string, string) (ImplResponse, error)
+	GetDashboardTemplates(context.Context) (ImplResponse, error)
 }
 
 // VersionAPIServicer defines the api actions for the VersionAPI service
@@ -65,11 +65,21 @@ func (c *ConfigurationAPIController) Routes() Routes {
 			"/v1/configs",
 			c.GetConfigurations,
 		},
+		"PatchConfigurationById": Route{
+			strings.ToUpper("Patch"),
+			"/v1/configs/{config-id}",
+			c.PatchConfigurationById,
+		},
 		"PostConfiguration": Route{
 			strings.ToUpper("Post"),
 			"/v1/configs",
 			c.PostConfiguration,
 		},
+		"PostFilterPreview": Route{
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/filter-preview",
+			c.PostFilterPreview,
+		},
 		"PutConfigurationById": Route{
 			strings.ToUpper("Put"),
 			"/v1/configs/{config-id}",
@@ -132,6 +142,34 @@ func (c *ConfigurationAPIController) GetConfigurations(w http.ResponseWriter, r
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// PatchConfigurationById - Partially updates a configuration
+func (c *ConfigurationAPIController) PatchConfigurationById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	bodyParam := map[string]interface{}{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&bodyParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.PatchConfigurationById(r.Context(), configIdParam, bodyParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // PostConfiguration - Creates a configuration
 func (c *ConfigurationAPIController) PostConfiguration(w http.ResponseWriter, r *http.Request) {
 	configurationParam := Configuration{}
@@ -159,6 +197,38 @@ func (c *ConfigurationAPIController) PostConfiguration(w http.ResponseWriter, r
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// PostFilterPreview - Preview an asset filter
+func (c *ConfigurationAPIController) PostFilterPreview(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	filterRuleParam := [][]FilterRule{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&filterRuleParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertRecurseInterfaceRequired(filterRuleParam, AssertFilterRuleRequired); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PostFilterPreview(r.Context(), configIdParam, filterRuleParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // PutConfigurationById - Updates a configuration
 func (c *ConfigurationAPIController) PutConfigurationById(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -54,6 +54,11 @@ func (c *CustomizationAPIController) Routes() Routes {
 			"/v1/dashboard-templates/{dashboard-template-name}",
 			c.GetDashboardTemplateByName,
 		},
+		"GetDashboardTemplates": Route{
+			strings.ToUpper("Get"),
+			"/v1/dashboard-templates",
+			c.GetDashboardTemplates,
+		},
 	}
 }
 
@@ -72,3 +77,15 @@ func (c *CustomizationAPIController) GetDashboardTemplateByName(w http.ResponseW
 	// If no error, encode the body and the result code
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
+
+// GetDashboardTemplates - List the dashboard templates
+func (c *CustomizationAPIController) GetDashboardTemplates(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.GetDashboardTemplates(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
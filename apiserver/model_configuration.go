@@ -18,12 +18,18 @@ type Configuration struct {
 	// The username for API login
 	Username string `json:"username,omitempty"`
 
-	// The password for API login
+	// The password for API login. Write-only, responses never contain the password, but the placeholder `********` if one is stored (see `passwordSet`). The placeholder can be sent back unchanged, e.g. in a PUT of a fetched configuration, to keep the stored password.
 	Password string `json:"password,omitempty"`
 
-	// The key for accessing the API
+	// Set to `true` by the app if a password is stored
+	PasswordSet *bool `json:"passwordSet,omitempty"`
+
+	// The key for accessing the API. Write-only, responses never contain the key, but the placeholder `********` if one is stored (see `apiKeySet`). The placeholder can be sent back unchanged, e.g. in a PUT of a fetched configuration, to keep the stored key.
 	ApiKey string `json:"apiKey,omitempty"`
 
+	// Set to `true` by the app if an API key is stored
+	ApiKeySet *bool `json:"apiKeySet,omitempty"`
+
 	// The url for the API
 	Url string `json:"url,omitempty"`
 
@@ -36,6 +42,21 @@ type Configuration struct {
 	// Timeout in seconds
 	RequestTimeout *int32 `json:"requestTimeout,omitempty"`
 
+	// Maximum number of requests per minute sent to the CoffeeCloud API. The requests are not limited if not set or set to 0.
+	RequestsPerMinute *int32 `json:"requestsPerMinute,omitempty"`
+
+	// Maximum number of groups collected in parallel. Defaults to 4 if not set or set to 0. Set to 1 to collect the groups one after another.
+	ConcurrentGroups *int32 `json:"concurrentGroups,omitempty"`
+
+	// Handling of assets for machines and groups no longer present in CoffeeCloud or excluded by the asset filter: `keep` leaves them unchanged, `inactive` sets their `active` attribute to false, `archive` additionally moves them below an "Archived" asset and `delete` deletes them. Defaults to `inactive`.
+	RemovedAssetPolicy *string `json:"removedAssetPolicy,omitempty"`
+
+	// If true, asset names changed manually in Eliona are kept instead of being overwritten by renames in CoffeeCloud.
+	PreferElionaNames *bool `json:"preferElionaNames,omitempty"`
+
+	// Number of cycles after which unchanged data is sent to Eliona again. Defaults to 10 if not set or set to 0. Set to 1 to send all data in every cycle.
+	HeartbeatCycles *int32 `json:"heartbeatCycles,omitempty"`
+
 	// Array of rules combined by logical OR
 	AssetFilter [][]FilterRule `json:"assetFilter,omitempty"`
 
@@ -0,0 +1,39 @@
+/*
+ * App CoffeeCloud API
+ *
+ * API to access and configure the app CoffeeCloud
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// DashboardTemplate - A dashboard template provided by the app
+type DashboardTemplate struct {
+
+	// The name of the dashboard template
+	Name string `json:"name"`
+
+	// What the dashboard created from the template shows
+	Description string `json:"description,omitempty"`
+}
+
+// AssertDashboardTemplateRequired checks if the required fields are not zero-ed
+func AssertDashboardTemplateRequired(obj DashboardTemplate) error {
+	elements := map[string]interface{}{
+		"name": obj.Name,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertDashboardTemplateConstraints checks if the values respects the defined constraints
+func AssertDashboardTemplateConstraints(obj DashboardTemplate) error {
+	return nil
+}
@@ -0,0 +1,57 @@
+/*
+ * App CoffeeCloud API
+ *
+ * API to access and configure the app CoffeeCloud
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// FilterPreviewGroup - A group as selected by a candidate asset filter
+type FilterPreviewGroup struct {
+
+	// The CoffeeCloud ID of the group
+	GroupId string `json:"groupId"`
+
+	// The name of the group
+	GroupName string `json:"groupName"`
+
+	// The filterable parameters of the group with their values
+	Properties map[string]string `json:"properties,omitempty"`
+
+	// Whether the group is included by the filter
+	Included bool `json:"included"`
+
+	// Which rules decided whether the group is included
+	Reason string `json:"reason,omitempty"`
+
+	// The machines placed below the group
+	Machines []FilterPreviewMachine `json:"machines,omitempty"`
+}
+
+// AssertFilterPreviewGroupRequired checks if the required fields are not zero-ed
+func AssertFilterPreviewGroupRequired(obj FilterPreviewGroup) error {
+	elements := map[string]interface{}{
+		"groupId":   obj.GroupId,
+		"groupName": obj.GroupName,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Machines {
+		if err := AssertFilterPreviewMachineRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertFilterPreviewGroupConstraints checks if the values respects the defined constraints
+func AssertFilterPreviewGroupConstraints(obj FilterPreviewGroup) error {
+	return nil
+}
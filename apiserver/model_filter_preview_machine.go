@@ -0,0 +1,48 @@
+/*
+ * App CoffeeCloud API
+ *
+ * API to access and configure the app CoffeeCloud
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// FilterPreviewMachine - A machine as selected by a candidate asset filter
+type FilterPreviewMachine struct {
+
+	// The serial number of the machine
+	SerialNumber string `json:"serialNumber"`
+
+	// The name of the machine
+	MachineName string `json:"machineName,omitempty"`
+
+	// The filterable parameters of the machine with their values
+	Properties map[string]string `json:"properties,omitempty"`
+
+	// Whether the machine is included by the filter
+	Included bool `json:"included"`
+
+	// Which rules decided whether the machine is included
+	Reason string `json:"reason,omitempty"`
+}
+
+// AssertFilterPreviewMachineRequired checks if the required fields are not zero-ed
+func AssertFilterPreviewMachineRequired(obj FilterPreviewMachine) error {
+	elements := map[string]interface{}{
+		"serialNumber": obj.SerialNumber,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertFilterPreviewMachineConstraints checks if the values respects the defined constraints
+func AssertFilterPreviewMachineConstraints(obj FilterPreviewMachine) error {
+	return nil
+}
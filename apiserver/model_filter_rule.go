@@ -13,7 +13,17 @@ package apiserver
 type FilterRule struct {
 	Parameter string `json:"parameter,omitempty"`
 
+	// How the value of the parameter is compared. Defaults to regex.
+	Operator string `json:"operator,omitempty"`
+
+	// Regular expression for the operators regex and not_regex
 	Regex string `json:"regex,omitempty"`
+
+	// Value for the operators eq, lt and gt. The operators lt and gt compare numerically.
+	Value string `json:"value,omitempty"`
+
+	// Values for the operators in and not_in
+	Values []string `json:"values,omitempty"`
 }
 
 // AssertFilterRuleRequired checks if the required fields are not zero-ed
@@ -16,22 +16,35 @@
 package apiservices
 
 import (
+	"bytes"
 	"coffeecloud/apiserver"
 	"coffeecloud/conf"
+	"coffeecloud/eliona"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
 )
 
 // ConfigurationApiService is a service that implements the logic for the ConfigurationApiServicer
 // This service should implement the business logic for every endpoint for the ConfigurationApi API.
 // Include any external packages or services that will be required by this service.
 type ConfigurationApiService struct {
+	previewFilter FilterPreviewer
 }
 
+// FilterPreviewer collects the groups and machines of the configuration and evaluates the filter
+// for each of them without writing to Eliona. It stops when the context is done.
+type FilterPreviewer func(ctx context.Context, config apiserver.Configuration, filter [][]apiserver.FilterRule) ([]apiserver.FilterPreviewGroup, error)
+
 // NewConfigurationApiService creates a default api service
-func NewConfigurationApiService() apiserver.ConfigurationAPIServicer {
-	return &ConfigurationApiService{}
+func NewConfigurationApiService(previewFilter FilterPreviewer) apiserver.ConfigurationAPIServicer {
+	return &ConfigurationApiService{
+		previewFilter: previewFilter,
+	}
 }
 
 func (s *ConfigurationApiService) GetConfigurations(ctx context.Context) (apiserver.ImplResponse, error) {
@@ -39,35 +52,120 @@ func (s *ConfigurationApiService) GetConfigurations(ctx context.Context) (apiser
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
+	for i := range configs {
+		configs[i] = maskSecrets(configs[i])
+	}
 	return apiserver.Response(http.StatusOK, configs), nil
 }
 
 func (s *ConfigurationApiService) PostConfiguration(ctx context.Context, config apiserver.Configuration) (apiserver.ImplResponse, error) {
+	if err := eliona.ValidateFilter(config.AssetFilter); err != nil {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	if config.Password == secretPlaceholder || config.ApiKey == secretPlaceholder {
+		return apiserver.Response(http.StatusBadRequest, "password and API key must be set for new configurations"), nil
+	}
 	insertedConfig, err := conf.InsertConfig(ctx, config)
+	if errors.Is(err, conf.ErrEncryptedCredential) {
+		return apiserver.Response(http.StatusBadRequest, encryptedCredentialMessage), nil
+	}
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
-	return apiserver.Response(http.StatusCreated, insertedConfig), nil
+	return apiserver.Response(http.StatusCreated, maskSecrets(insertedConfig)), nil
 }
 
 func (s *ConfigurationApiService) GetConfigurationById(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
 	config, err := conf.GetConfig(ctx, configId)
-	if errors.Is(err, conf.ErrBadRequest) {
-		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
 	}
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
-	return apiserver.Response(http.StatusOK, config), nil
+	return apiserver.Response(http.StatusOK, maskSecrets(*config)), nil
 }
 
 func (s *ConfigurationApiService) PutConfigurationById(ctx context.Context, configId int64, config apiserver.Configuration) (apiserver.ImplResponse, error) {
+	if err := eliona.ValidateFilter(config.AssetFilter); err != nil {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	config, err := keepStoredSecrets(ctx, configId, config)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	config.Id = &configId
+	updatedConfig, err := conf.UpdateConfig(ctx, config)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if errors.Is(err, conf.ErrEncryptedCredential) {
+		return apiserver.Response(http.StatusBadRequest, encryptedCredentialMessage), nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, maskSecrets(updatedConfig)), nil
+}
+
+func (s *ConfigurationApiService) PatchConfigurationById(ctx context.Context, configId int64, patch map[string]interface{}) (apiserver.ImplResponse, error) {
+	stored, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	config, err := mergePatchConfig(*stored, patch)
+	if err != nil {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	if err := eliona.ValidateFilter(config.AssetFilter); err != nil {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	// The stored credentials are kept encrypted, placeholders keep them as well
+	if config.Password == secretPlaceholder {
+		config.Password = stored.Password
+	}
+	if config.ApiKey == secretPlaceholder {
+		config.ApiKey = stored.ApiKey
+	}
 	config.Id = &configId
-	upsertedConfig, err := conf.InsertConfig(ctx, config)
+	updatedConfig, err := conf.UpdateConfig(ctx, config)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if errors.Is(err, conf.ErrEncryptedCredential) {
+		return apiserver.Response(http.StatusBadRequest, encryptedCredentialMessage), nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, maskSecrets(updatedConfig)), nil
+}
+
+func (s *ConfigurationApiService) PostFilterPreview(ctx context.Context, configId int64, filter [][]apiserver.FilterRule) (apiserver.ImplResponse, error) {
+	if err := eliona.ValidateFilter(filter); err != nil {
+		return apiserver.Response(http.StatusBadRequest, err.Error()), nil
+	}
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrNotFound) {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	groups, err := s.previewFilter(ctx, *config, filter)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return apiserver.Response(http.StatusGatewayTimeout, "filter preview timed out"), nil
+	}
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
-	return apiserver.Response(http.StatusCreated, upsertedConfig), nil
+	return apiserver.Response(http.StatusOK, groups), nil
 }
 
 func (s *ConfigurationApiService) DeleteConfigurationById(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
@@ -80,3 +178,88 @@ func (s *ConfigurationApiService) DeleteConfigurationById(ctx context.Context, c
 	}
 	return apiserver.ImplResponse{Code: http.StatusNoContent}, nil
 }
+
+// secretPlaceholder replaces the password and API key in responses. Sending it back keeps the stored value.
+const secretPlaceholder = "********"
+
+// encryptedCredentialMessage answers requests with encrypted credentials other than the stored ones.
+const encryptedCredentialMessage = "password and API key must be sent in plain text or as placeholder"
+
+// maskSecrets replaces the password and API key with the placeholder and flags whether they are set.
+func maskSecrets(config apiserver.Configuration) apiserver.Configuration {
+	config.PasswordSet = common.Ptr(config.Password != "")
+	config.ApiKeySet = common.Ptr(config.ApiKey != "")
+	if config.Password != "" {
+		config.Password = secretPlaceholder
+	}
+	if config.ApiKey != "" {
+		config.ApiKey = secretPlaceholder
+	}
+	return config
+}
+
+// keepStoredSecrets replaces placeholders in the configuration with the stored password and API key.
+// It returns conf.ErrNotFound if a placeholder is sent for a configuration not stored yet.
+func keepStoredSecrets(ctx context.Context, configId int64, config apiserver.Configuration) (apiserver.Configuration, error) {
+	if config.Password != secretPlaceholder && config.ApiKey != secretPlaceholder {
+		return config, nil
+	}
+	stored, err := conf.GetConfig(ctx, configId)
+	if err != nil {
+		return apiserver.Configuration{}, err
+	}
+	if config.Password == secretPlaceholder {
+		config.Password = stored.Password
+	}
+	if config.ApiKey == secretPlaceholder {
+		config.ApiKey = stored.ApiKey
+	}
+	return config, nil
+}
+
+// mergePatchConfig applies the JSON merge patch (RFC 7396) to the configuration. Fields set to null
+// in the patch are reset, fields missing in the patch are kept.
+func mergePatchConfig(config apiserver.Configuration, patch map[string]interface{}) (apiserver.Configuration, error) {
+	original, err := json.Marshal(config)
+	if err != nil {
+		return apiserver.Configuration{}, fmt.Errorf("marshalling configuration: %v", err)
+	}
+	var document map[string]interface{}
+	if err := json.Unmarshal(original, &document); err != nil {
+		return apiserver.Configuration{}, fmt.Errorf("unmarshalling configuration: %v", err)
+	}
+	patched, err := json.Marshal(mergePatch(document, patch))
+	if err != nil {
+		return apiserver.Configuration{}, fmt.Errorf("marshalling patched configuration: %v", err)
+	}
+	var patchedConfig apiserver.Configuration
+	d := json.NewDecoder(bytes.NewReader(patched))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&patchedConfig); err != nil {
+		return apiserver.Configuration{}, fmt.Errorf("invalid patch: %v", err)
+	}
+	if err := apiserver.AssertConfigurationRequired(patchedConfig); err != nil {
+		return apiserver.Configuration{}, fmt.Errorf("invalid patch: %v", err)
+	}
+	return patchedConfig, nil
+}
+
+// mergePatch merges the patch into the target as defined by RFC 7396.
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	patchObject, isObject := patch.(map[string]interface{})
+	if !isObject {
+		return patch
+	}
+	targetObject, isObject := target.(map[string]interface{})
+	if !isObject {
+		targetObject = make(map[string]interface{})
+	}
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+			continue
+		}
+		targetObject[name] = mergePatch(targetObject[name], value)
+	}
+	return targetObject
+}
@@ -0,0 +1,140 @@
+package apiservices
+
+import (
+	"coffeecloud/apiserver"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMergePatchConfig(t *testing.T) {
+	stored := apiserver.Configuration{
+		Id:              common.Ptr[int64](1),
+		Username:        "user",
+		Password:        "enc:v1:secret",
+		Url:             "https://coffeecloud",
+		Enable:          common.Ptr(true),
+		RefreshInterval: 60,
+		ProjectIDs:      common.Ptr([]string{"10"}),
+		AssetFilter:     [][]apiserver.FilterRule{{{Parameter: "machine_name", Regex: "^brew"}}},
+	}
+	var patch map[string]interface{}
+	if err := json.Unmarshal([]byte(`{"enable": false, "projectIDs": ["10", "11"], "assetFilter": null}`), &patch); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := mergePatchConfig(stored, patch)
+	if err != nil {
+		t.Fatalf("mergePatchConfig() error = %v", err)
+	}
+	want := stored
+	want.Enable = common.Ptr(false)
+	want.ProjectIDs = common.Ptr([]string{"10", "11"})
+	want.AssetFilter = nil
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergePatchConfig() = %+v, want %+v", got, want)
+	}
+
+	if _, err := mergePatchConfig(stored, map[string]interface{}{"unknown": 1}); err == nil {
+		t.Errorf("mergePatchConfig() accepted unknown field")
+	}
+	if _, err := mergePatchConfig(stored, map[string]interface{}{"refreshInterval": "often"}); err == nil {
+		t.Errorf("mergePatchConfig() accepted wrong type")
+	}
+}
+
+func TestMaskSecrets(t *testing.T) {
+	masked := maskSecrets(apiserver.Configuration{Password: "secret"})
+	if masked.Password != secretPlaceholder || !*masked.PasswordSet {
+		t.Errorf("password not masked: %q, set %v", masked.Password, *masked.PasswordSet)
+	}
+	if masked.ApiKey != "" || *masked.ApiKeySet {
+		t.Errorf("empty API key masked: %q, set %v", masked.ApiKey, *masked.ApiKeySet)
+	}
+}
+
+func TestUnknownConfiguration(t *testing.T) {
+	sql.Register("empty", emptyDriver{})
+	db, err := sql.Open("empty", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	boil.SetDB(db)
+	t.Cleanup(func() { boil.SetDB(nil) })
+
+	service := NewConfigurationApiService(nil)
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		request func() (apiserver.ImplResponse, error)
+	}{
+		{"get", func() (apiserver.ImplResponse, error) {
+			return service.GetConfigurationById(ctx, 42)
+		}},
+		{"patch", func() (apiserver.ImplResponse, error) {
+			return service.PatchConfigurationById(ctx, 42, map[string]interface{}{"enable": false})
+		}},
+		{"put", func() (apiserver.ImplResponse, error) {
+			return service.PutConfigurationById(ctx, 42, apiserver.Configuration{Password: "secret"})
+		}},
+		{"put with placeholder", func() (apiserver.ImplResponse, error) {
+			return service.PutConfigurationById(ctx, 42, apiserver.Configuration{Password: secretPlaceholder})
+		}},
+		{"filter preview", func() (apiserver.ImplResponse, error) {
+			return service.PostFilterPreview(ctx, 42, nil)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := tt.request()
+			if err != nil || response.Code != http.StatusNotFound {
+				t.Errorf("got %d, %v, want %d", response.Code, err, http.StatusNotFound)
+			}
+		})
+	}
+}
+
+func TestEncryptedCredentials(t *testing.T) {
+	service := NewConfigurationApiService(nil)
+	response, err := service.PostConfiguration(context.Background(), apiserver.Configuration{
+		Username: "user",
+		Password: "enc:v1:forged",
+		Url:      "https://coffeecloud",
+	})
+	if err != nil || response.Code != http.StatusBadRequest {
+		t.Errorf("got %d, %v, want %d", response.Code, err, http.StatusBadRequest)
+	}
+}
+
+// emptyDriver is a database driver without any rows.
+type emptyDriver struct{}
+
+func (emptyDriver) Open(string) (driver.Conn, error) { return emptyConn{}, nil }
+
+type emptyConn struct{}
+
+func (emptyConn) Prepare(string) (driver.Stmt, error) { return emptyStmt{}, nil }
+func (emptyConn) Close() error                        { return nil }
+func (emptyConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type emptyStmt struct{}
+
+func (emptyStmt) Close() error                               { return nil }
+func (emptyStmt) NumInput() int                              { return -1 }
+func (emptyStmt) Exec([]driver.Value) (driver.Result, error) { return driver.RowsAffected(0), nil }
+func (emptyStmt) Query([]driver.Value) (driver.Rows, error)  { return emptyRows{}, nil }
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string         { return nil }
+func (emptyRows) Close() error              { return nil }
+func (emptyRows) Next([]driver.Value) error { return io.EOF }
@@ -35,13 +35,25 @@ func NewCustomizationApiService() apiserver.CustomizationAPIServicer {
 
 // GetDashboardTemplateByName - Get a full dashboard template
 func (s *CustomizationApiService) GetDashboardTemplateByName(ctx context.Context, dashboardTemplateName string, projectId string) (apiserver.ImplResponse, error) {
-	if dashboardTemplateName == "CoffeeCloud" {
-		dashboard, err := eliona.CoffeeCloudDashboard(projectId)
-		if err != nil {
-			return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
-		}
-		return apiserver.Response(http.StatusOK, dashboard), nil
-	} else {
+	template, found := eliona.GetDashboardTemplate(dashboardTemplateName)
+	if !found {
 		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
 	}
+	dashboard, err := template.Build(projectId)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, dashboard), nil
+}
+
+// GetDashboardTemplates - List the dashboard templates
+func (s *CustomizationApiService) GetDashboardTemplates(ctx context.Context) (apiserver.ImplResponse, error) {
+	var templates []apiserver.DashboardTemplate
+	for _, template := range eliona.DashboardTemplates() {
+		templates = append(templates, apiserver.DashboardTemplate{
+			Name:        template.Name,
+			Description: template.Description,
+		})
+	}
+	return apiserver.Response(http.StatusOK, templates), nil
 }
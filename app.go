@@ -18,6 +18,7 @@ package main
 import (
 	"coffeecloud/apiserver"
 	"coffeecloud/apiservices"
+	"coffeecloud/appdb"
 	"coffeecloud/coffeecloud"
 	"coffeecloud/conf"
 	"coffeecloud/eliona"
@@ -26,7 +27,10 @@ import (
 	"github.com/eliona-smart-building-assistant/go-eliona/app"
 	"github.com/eliona-smart-building-assistant/go-utils/db"
 	"net/http"
+	"sort"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/eliona-smart-building-assistant/go-utils/common"
@@ -41,11 +45,27 @@ func initialization() {
 	conn := db.NewInitConnectionWithContextAndApplicationName(ctx, app.AppName())
 	defer conn.Close(ctx)
 
+	key, err := conf.LoadCredentialsKey()
+	if err != nil {
+		log.Fatal("conf", "loading credentials key: %v", err)
+	}
+	if key == nil {
+		log.Warn("conf", "No credentials key configured, CoffeeCloud credentials are stored unencrypted. Set %s or %s.", conf.CredentialsKeyEnv, conf.CredentialsKeyFileEnv)
+	}
+
 	// Init the app before the first run.
 	app.Init(conn, app.AppName(),
 		app.ExecSqlFile("conf/init.sql"),
 		eliona.Init,
 	)
+
+	// Patch installations initialized by previous versions. On new installations, the patches
+	// complete the schema created by init.sql.
+	app.Patch(conn, app.AppName(), "v1.1.0",
+		app.ExecSqlFile("conf/v1.1.0.sql"),
+		// Asset types of existing installations need the attributes added since
+		eliona.Init,
+	)
 }
 
 func collectData() {
@@ -97,17 +117,33 @@ func collectData() {
 		common.RunOnceWithParam(func(config apiserver.Configuration) {
 			log.Info("main", "collecting %d started", *config.Id)
 
-			groups, err := collectGroupedMachines(config)
+			cycleStart := time.Now()
+			errorCursor, err := conf.GetMachineErrorCursor(context.Background(), *config.Id)
+			if err != nil {
+				log.Error("conf", "error getting machine error cursor: %v", err)
+				return
+			}
+			groups, failures, err := collectGroupedMachines(config, errorCursor.FetchFrom)
 			if err != nil {
 				log.Error("coffeecloud", "error collection machines: %v", err)
 				return
 			} else {
+				for _, failure := range failures {
+					log.Error("coffeecloud", "partial failure collecting %d: %v", *config.Id, failure)
+				}
 
-				err = sendGroupedMachinesAndData(config, groups)
+				err = sendGroupedMachinesAndData(config, groups, errorCursor.AlarmsFrom)
 				if err != nil {
 					log.Error("coffeecloud", "error sending assets and data: %v", err)
 					return
+				} else if len(failures) > 0 {
+					log.Warn("main", "collecting %d finished with %d failed parts, affected data is marked as stale", *config.Id, len(failures))
 				} else {
+					// All errors until the start of the cycle are fetched and sent now
+					err = conf.SetMachineErrorCursor(context.Background(), *config.Id, cycleStart.Add(-errorCursorOverlap))
+					if err != nil {
+						log.Error("conf", "error storing machine error cursor: %v", err)
+					}
 					log.Info("main", "collecting %d successful finished", *config.Id)
 				}
 
@@ -118,35 +154,86 @@ func collectData() {
 	}
 }
 
-func sendGroupedMachinesAndData(config apiserver.Configuration, groups []eliona.MachineGroup) error {
+// errorCursorOverlap is fetched again in the next cycle to catch errors CoffeeCloud reports with
+// a delay. Errors fetched twice are sent only once, as they are already stored.
+const errorCursorOverlap = time.Hour
+
+// sendGroupedMachinesAndData sends the assets and data to all projects of the configuration. Errors
+// not sent to a project yet are sent as events, those occurred from alarmsFrom also raise alarms.
+// If alarmsFrom is nil, no alarms are raised, e.g. for the history fetched on the first sync.
+func sendGroupedMachinesAndData(config apiserver.Configuration, groups []eliona.MachineGroup, alarmsFrom *time.Time) error {
 
 	if config.ProjectIDs == nil || len(*config.ProjectIDs) == 0 {
 		log.Info("eliona", "No project id defined in configuration %d. No data is send to Eliona.", config.Id)
 		return nil
 	}
 
+	alarmRules := &eliona.ErrorAlarmRules{}
+	cache := dataCache(config)
+	groupKPIs, rootKPIs := aggregateKPIs(groups)
 	for _, projectId := range *config.ProjectIDs {
 
-		rootAssetId, err := createAssetFirstTime(*config.Id, projectId, eliona.CoffeeCloudRootAssetType, nil, eliona.CoffeeCloudRootAssetType, "CoffeeCloud")
+		rootAssetId, err := createOrUpdateAsset(config, projectId, eliona.CoffeeCloudRootAssetType, nil, eliona.CoffeeCloudRootAssetType, "CoffeeCloud", nil)
+		if err != nil {
+			return fmt.Errorf("create root asset: %w", err)
+		}
+
+		err = eliona.UpsertData(rootAssetId, eliona.CoffeeCloudRootAssetType, cache, rootKPIs)
 		if err != nil {
-			return fmt.Errorf("create root asset first time: %w", err)
+			return fmt.Errorf("upserting root data: %w", err)
 		}
 
+		groupAssetIds := make(map[string]int32)
 		for _, group := range groups {
 
-			groupAssetId, err := createAssetFirstTime(*config.Id, projectId, eliona.CoffeeCloudGroupAssetType+"_"+group.GroupID, &rootAssetId, eliona.CoffeeCloudGroupAssetType, group.GroupName)
+			parentAssetId := rootAssetId
+			if assetId, exists := groupAssetIds[group.ParentGroupID]; exists {
+				parentAssetId = assetId
+			}
+			groupAssetId, err := createOrUpdateAsset(config, projectId, eliona.CoffeeCloudGroupAssetType+"_"+group.GroupID, &parentAssetId, eliona.CoffeeCloudGroupAssetType, group.GroupName, nil)
 			if err != nil {
-				return fmt.Errorf("create group asset first time: %w", err)
+				return fmt.Errorf("create group asset: %w", err)
+			}
+			groupAssetIds[group.GroupID] = groupAssetId
+
+			err = eliona.UpsertData(groupAssetId, eliona.CoffeeCloudGroupAssetType, cache, group, groupKPIs[group.GroupID])
+			if err != nil {
+				return fmt.Errorf("upserting group data: %w", err)
 			}
 
 			for _, machine := range group.Machines {
 
-				machineAssetId, err := createAssetFirstTime(*config.Id, projectId, eliona.CoffeeCloudMachineAssetType+"_"+machine.MachineID, &groupAssetId, eliona.CoffeeCloudMachineAssetType, machine.MachineName)
+				machineAssetId, err := createOrUpdateAsset(config, projectId, eliona.CoffeeCloudMachineAssetType+"_"+machine.MachineID, &groupAssetId, eliona.CoffeeCloudMachineAssetType, machine.MachineName, machine.Location)
+				if err != nil {
+					return fmt.Errorf("create machine asset: %w", err)
+				}
+
+				// Send the errors not sent to the project yet oldest first before the current data
+				machineErrors, err := conf.NewMachineErrors(context.Background(), *config.Id, projectId, machine.Errors)
+				if err != nil {
+					return fmt.Errorf("checking machine errors: %w", err)
+				}
+				for i := len(machineErrors) - 1; i >= 0; i-- {
+					err = eliona.UpsertErrorEvent(machineAssetId, machineErrors[i])
+					if err != nil {
+						return fmt.Errorf("upserting machine error event: %w", err)
+					}
+					if alarmsFrom != nil && !machineErrors[i].Timestamp.Before(*alarmsFrom) {
+						err = alarmRules.RaiseErrorAlarm(machineAssetId, machineErrors[i])
+						if err != nil {
+							return fmt.Errorf("raising machine error alarm: %w", err)
+						}
+					}
+				}
+				if len(machineErrors) > 0 {
+					cache.Invalidate(machineAssetId)
+				}
+				err = conf.InsertMachineErrors(context.Background(), *config.Id, projectId, machineErrors)
 				if err != nil {
-					return fmt.Errorf("create machine asset first time: %w", err)
+					return fmt.Errorf("storing machine errors: %w", err)
 				}
 
-				err = eliona.UpsertData(machineAssetId, eliona.CoffeeCloudMachineAssetType, machine)
+				err = eliona.UpsertData(machineAssetId, eliona.CoffeeCloudMachineAssetType, cache, machine)
 				if err != nil {
 					return fmt.Errorf("upserting machine data: %w", err)
 				}
@@ -154,127 +241,634 @@ func sendGroupedMachinesAndData(config apiserver.Configuration, groups []eliona.
 
 		}
 
+		err = reconcileAssets(config, projectId, rootAssetId, groups)
+		if err != nil {
+			return fmt.Errorf("reconciling assets: %w", err)
+		}
 	}
-
 	return nil
 }
 
-func collectGroupedMachines(config apiserver.Configuration) ([]eliona.MachineGroup, error) {
+// aggregateKPIs returns the KPIs of each group including its subgroups and the KPIs of all machines.
+func aggregateKPIs(groups []eliona.MachineGroup) (map[string]eliona.KPIs, eliona.KPIs) {
+	parents := make(map[string]string)
+	for _, group := range groups {
+		parents[group.GroupID] = group.ParentGroupID
+	}
+	groupKPIs := make(map[string]eliona.KPIs)
+	var rootKPIs eliona.KPIs
+	for _, group := range groups {
+		var kpis eliona.KPIs
+		for _, machine := range group.Machines {
+			kpis.AddMachine(machine)
+		}
+		rootKPIs.Add(kpis)
+		// add the machines to the group and all its ancestors
+		visited := make(map[string]bool)
+		for groupId := group.GroupID; groupId != "" && !visited[groupId]; groupId = parents[groupId] {
+			visited[groupId] = true
+			ancestorKPIs := groupKPIs[groupId]
+			ancestorKPIs.Add(kpis)
+			groupKPIs[groupId] = ancestorKPIs
+		}
+	}
+	return groupKPIs, rootKPIs
+}
 
-	var eliGroups []eliona.MachineGroup
+// collectGroupedMachines collects all groups and machines. Failures of single groups or endpoints
+// do not stop the collection. They are returned as failures and the affected data is marked as stale.
+// Errors are fetched from errorsFrom, or the whole history if it is nil.
+func collectGroupedMachines(config apiserver.Configuration, errorsFrom *time.Time) ([]eliona.MachineGroup, []error, error) {
+
+	client, err := coffeeCloudClient(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	client.StartCycle(time.Duration(config.RefreshInterval) * time.Second)
+	client.SetRequestsPerMinute(common.Val(config.RequestsPerMinute))
 
-	ccToken, err := coffeecloud.GetAuthToken(config.Url, config.Username, config.Password, time.Duration(*config.RequestTimeout)*time.Second)
+	ccGroups, err := client.GetGroups()
 	if err != nil {
-		return eliGroups, fmt.Errorf("getting access token: %w", err)
+		return nil, nil, fmt.Errorf("getting groups: %w", err)
 	}
-	if ccToken == nil {
-		return eliGroups, fmt.Errorf("no access token received: %w", err)
+	ccGroups = coffeecloud.FlattenGroups(ccGroups)
+	client.WarnIfOverBudget(len(ccGroups), time.Duration(config.RefreshInterval)*time.Second)
+
+	// Let CoffeeCloud preselect the machines if the filter allows only certain serial numbers
+	criteria := coffeecloud.Criteria{
+		SerialNumbers: eliona.SerialNumbersFromFilter(config.AssetFilter),
 	}
 
-	ccGroups, err := coffeecloud.GetGroups(config.Url, config.ApiKey, *ccToken, time.Duration(*config.RequestTimeout)*time.Second)
+	eliGroups, failures, err := collectGroups(config, client, ccGroups, criteria, errorsFrom)
 	if err != nil {
-		return eliGroups, fmt.Errorf("getting groups: %w", err)
+		return nil, nil, err
+	}
+	cacheGroups(*config.Id, eliGroups)
+	membership := newGroupMembership(ccGroups, eliGroups)
+	eliGroups = arrangeGroupHierarchy(ccGroups, eliGroups)
+	eliGroups, err = keepMachinesInStaleGroups(config, eliGroups, membership)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	for _, ccGroup := range ccGroups {
+	if err := detectCleanings(config, eliGroups); err != nil {
+		return nil, nil, err
+	}
+	if err := detectMoves(config, eliGroups, membership); err != nil {
+		return nil, nil, err
+	}
+	return eliGroups, failures, nil
+}
 
-		log.Debug("coffeecloud", "found group %s", ccGroup.Name)
-		eliGroup := eliona.MachineGroup{
-			GroupID:   strconv.Itoa(int(ccGroup.ID)),
-			GroupName: ccGroup.Name,
+// collectGroups collects the groups in parallel, limited by the configured number of concurrent
+// groups. The collected groups are returned in the order of the CoffeeCloud groups.
+func collectGroups(config apiserver.Configuration, client *coffeecloud.Client, ccGroups []coffeecloud.CoffeeGroup, criteria coffeecloud.Criteria, errorsFrom *time.Time) ([]eliona.MachineGroup, []error, error) {
+	type result struct {
+		group    *eliona.MachineGroup
+		failures []error
+		err      error
+	}
+	results := make([]result, len(ccGroups))
+	indexes := make(chan int)
+	var failed atomic.Bool
+	var wg sync.WaitGroup
+	for worker := 0; worker < concurrentGroups(config); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				if failed.Load() {
+					continue
+				}
+				group, failures, err := collectGroup(config, client, ccGroups[index], criteria, errorsFrom)
+				results[index] = result{group: group, failures: failures, err: err}
+				if err != nil {
+					failed.Store(true)
+				}
+			}
+		}()
+	}
+	for index := range ccGroups {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	var eliGroups []eliona.MachineGroup
+	var failures []error
+	for _, result := range results {
+		if result.err != nil {
+			return nil, nil, result.err
+		}
+		failures = append(failures, result.failures...)
+		if result.group != nil {
+			eliGroups = append(eliGroups, *result.group)
+		}
+	}
+	return eliGroups, failures, nil
+}
+
+// defaultConcurrentGroups is used if the configuration does not define the number of concurrent groups.
+const defaultConcurrentGroups = 4
+
+func concurrentGroups(config apiserver.Configuration) int {
+	if config.ConcurrentGroups == nil || *config.ConcurrentGroups < 1 {
+		return defaultConcurrentGroups
+	}
+	return int(*config.ConcurrentGroups)
+}
+
+// collectGroup collects the machines of the group. It returns nil if the group is excluded by the filter.
+// If an endpoint fails, the failure is returned and the group is completed with the data of the
+// last cycle marked as stale.
+func collectGroup(config apiserver.Configuration, client *coffeecloud.Client, ccGroup coffeecloud.CoffeeGroup, criteria coffeecloud.Criteria, errorsFrom *time.Time) (*eliona.MachineGroup, []error, error) {
+	log.Debug("coffeecloud", "found group %s", ccGroup.Name)
+	eliGroup := eliona.MachineGroup{
+		GroupID:   strconv.Itoa(int(ccGroup.ID)),
+		GroupName: ccGroup.Name,
+		Active:    true,
+	}
+
+	shouldUse, err := eliona.AdheresToFilter(eliGroup, config.AssetFilter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("filtering group %s: %w", eliGroup.GroupName, err)
+	}
+	if !shouldUse {
+		return nil, nil, nil
+	}
+
+	var failures []error
+	cached, _ := cachedGroup(*config.Id, eliGroup.GroupID)
+
+	ccMachines, err := client.GetMachines(ccGroup.ID, criteria)
+	if err != nil {
+		failures = append(failures, fmt.Errorf("group %s: getting machines: %w", eliGroup.GroupName, err))
+		return staleGroup(eliGroup, cached), failures, nil
+	}
+	errorCriteria := criteria
+	errorCriteria.From = errorsFrom
+	ccMachineErrors, err := client.GetMachineErrors(ccGroup.ID, errorCriteria)
+	errorsStale := err != nil
+	if errorsStale {
+		failures = append(failures, fmt.Errorf("group %s: getting machine errors: %w", eliGroup.GroupName, err))
+	}
+	storedMachineErrors, err := conf.GetLatestMachineErrors(context.Background(), *config.Id, serialNumbers(ccMachines))
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting stored machine errors: %w", err)
+	}
+	ccHealthStatuses, err := client.GetHealthStatuses(ccGroup.ID)
+	healthStale := err != nil
+	if healthStale {
+		failures = append(failures, fmt.Errorf("group %s: getting health statuses: %w", eliGroup.GroupName, err))
+	}
+
+	for serialNumber, ccMachine := range ccMachines {
+		log.Debug("coffeecloud", "found machine %s", ccMachine.MachineName)
+		eliMachine := eliona.Machine{
+			MachineID:         ccMachine.ID,
+			MachineName:       ccMachine.MachineName,
+			SerialNumber:      serialNumber,
+			Firmware:          ccMachine.Origin.Firmware,
+			GroupName:         ccGroup.Name,
+			CupCount:          ccMachine.NumberOfCups,
+			HoursSinceCleaned: ccMachine.HoursSinceClean,
+			Location:          machineLocation(ccMachine),
+			Active:            true,
+		}
+		for _, ccMachineError := range ccMachineErrors[serialNumber] {
+			eliMachine.Errors = append(eliMachine.Errors, eliona.MachineError{
+				GroupID:          eliGroup.GroupID,
+				SerialNumber:     serialNumber,
+				ErrorCode:        ccMachineError.ErrorCode,
+				ErrorText:        ccMachineError.Error,
+				ErrorDescription: ccMachineError.ErrorShort,
+				Timestamp:        ccMachineError.Time(),
+			})
+		}
+		if latestError, exists := latestMachineError(eliMachine.Errors, storedMachineErrors[serialNumber]); exists {
+			eliMachine.ErrorCode = latestError.ErrorCode
+			eliMachine.ErrorText = latestError.ErrorText
+			eliMachine.ErrorDescription = latestError.ErrorDescription
+			eliMachine.ErrorOccurredAt = common.Ptr(latestError.Timestamp)
+		}
+		if ccHealthyStatus, exists := ccHealthStatuses[serialNumber]; exists {
+			eliMachine.EngineStatus = ccHealthyStatus.HealthStatus
+			eliMachine.HealthReason = ccHealthyStatus.Reason
+			eliMachine.HealthCause = ccHealthyStatus.Cause
 		}
+		if healthStale {
+			// Keep the health status of the last cycle
+			if cachedMachine, exists := findMachine(cached, serialNumber); exists {
+				eliMachine.EngineStatus = cachedMachine.EngineStatus
+				eliMachine.HealthReason = cachedMachine.HealthReason
+				eliMachine.HealthCause = cachedMachine.HealthCause
+			}
+		}
+		eliMachine.Stale = errorsStale || healthStale
 
-		shouldUse, err := eliona.AdheresToFilter(eliGroup, config.AssetFilter)
+		shouldUse, err = eliona.AdheresToFilter(eliMachine, config.AssetFilter)
 		if err != nil {
-			return eliGroups, fmt.Errorf("filtering group %s: %w", eliGroup.GroupName, err)
+			return nil, nil, fmt.Errorf("filtering machine %s: %w", eliMachine.MachineName, err)
 		}
 		if !shouldUse {
 			continue
 		}
 
-		ccMachines, err := coffeecloud.GetMachines(config.Url, config.ApiKey, *ccToken, ccGroup.ID, time.Duration(*config.RequestTimeout)*time.Second)
-		if err != nil {
-			return eliGroups, fmt.Errorf("getting machines: %w", err)
-		}
-		ccMachineErrors, err := coffeecloud.GetMachineErrors(config.Url, config.ApiKey, *ccToken, ccGroup.ID, time.Duration(*config.RequestTimeout)*time.Second)
-		if err != nil {
-			return eliGroups, fmt.Errorf("getting machine errors: %w", err)
+		eliGroup.Machines = append(eliGroup.Machines, eliMachine)
+	}
+	sort.Slice(eliGroup.Machines, func(i, j int) bool {
+		return eliGroup.Machines[i].SerialNumber < eliGroup.Machines[j].SerialNumber
+	})
+	return &eliGroup, failures, nil
+}
+
+// detectMoves records machines which moved to another group since the last cycle. The assets
+// of moved machines are placed below their new group when sent to Eliona. Machines of stale groups
+// are skipped, as their current group is not known.
+func detectMoves(config apiserver.Configuration, eliGroups []eliona.MachineGroup, membership groupMembership) error {
+	for _, group := range eliGroups {
+		if group.Stale {
+			continue
 		}
-		ccHealthStatuses, err := coffeecloud.GetHealthStatuses(config.Url, config.ApiKey, *ccToken, ccGroup.ID, time.Duration(*config.RequestTimeout)*time.Second)
-		if err != nil {
-			return eliGroups, fmt.Errorf("getting health statuses: %w", err)
-		}
-
-		for serialNumber, ccMachine := range ccMachines {
-			log.Debug("coffeecloud", "found machine %s", ccMachine.MachineName)
-			eliMachine := eliona.Machine{
-				MachineID:         ccMachine.ID,
-				MachineName:       ccMachine.MachineName,
-				SerialNumber:      serialNumber,
-				Firmware:          ccMachine.Origin.Firmware,
-				CupCount:          ccMachine.NumberOfCups,
-				HoursSinceCleaned: ccMachine.HoursSinceClean,
+		for _, machine := range group.Machines {
+			leftGroup := func(fromGroupId string) bool {
+				return membership.left(machine.SerialNumber, fromGroupId, group.GroupID)
+			}
+			fromGroupId, err := conf.DetectMove(context.Background(), *config.Id, machine.SerialNumber, machine.MachineID, group.GroupID, leftGroup, time.Now())
+			if err != nil {
+				return fmt.Errorf("detecting move of machine %s: %w", machine.MachineName, err)
 			}
-			if ccMachineError, exists := ccMachineErrors[serialNumber]; exists {
-				eliMachine.ErrorCode = ccMachineError.ErrorCode
-				eliMachine.ErrorText = ccMachineError.Error
-				eliMachine.ErrorDescription = ccMachineError.ErrorShort
+			if fromGroupId != nil {
+				log.Info("coffeecloud", "machine %s moved from group %s to group %s", machine.MachineName, *fromGroupId, group.GroupID)
 			}
-			if ccHealthyStatus, exists := ccHealthStatuses[serialNumber]; exists {
-				eliMachine.EngineStatus = ccHealthyStatus.HealthStatus
+		}
+	}
+	return nil
+}
+
+// groupMembership knows which machines the groups collected completely in this cycle contain,
+// including the machines of their subgroups, and the group hierarchy of CoffeeCloud.
+type groupMembership struct {
+	parents  map[string]string
+	machines map[string]map[string]bool
+}
+
+// newGroupMembership must be called before the machines are arranged in their deepest group.
+func newGroupMembership(ccGroups []coffeecloud.CoffeeGroup, eliGroups []eliona.MachineGroup) groupMembership {
+	membership := groupMembership{
+		parents:  make(map[string]string),
+		machines: make(map[string]map[string]bool),
+	}
+	for _, ccGroup := range ccGroups {
+		if ccGroup.ParentID != nil {
+			membership.parents[strconv.Itoa(int(ccGroup.ID))] = strconv.Itoa(int(*ccGroup.ParentID))
+		}
+	}
+	for _, eliGroup := range eliGroups {
+		if eliGroup.Stale {
+			continue
+		}
+		machines := make(map[string]bool)
+		for _, machine := range eliGroup.Machines {
+			machines[machine.SerialNumber] = true
+		}
+		membership.machines[eliGroup.GroupID] = machines
+	}
+	return membership
+}
+
+// left checks whether the machine found in the group toGroupId has left the group fromGroupId. If
+// fromGroupId was collected completely, the machine left it if it is not contained anymore. Otherwise,
+// e.g. because the group failed or is excluded by a changed filter, the machine only left it if
+// the groups are not in the same branch of the hierarchy.
+func (m groupMembership) left(serialNumber string, fromGroupId string, toGroupId string) bool {
+	if machines, collected := m.machines[fromGroupId]; collected {
+		return !machines[serialNumber]
+	}
+	return !m.isAncestor(fromGroupId, toGroupId) && !m.isAncestor(toGroupId, fromGroupId)
+}
+
+// isAncestor checks whether the group ancestorId is a parent of the group groupId or one of its parents.
+func (m groupMembership) isAncestor(ancestorId string, groupId string) bool {
+	visited := make(map[string]bool)
+	for parentId := m.parents[groupId]; parentId != "" && !visited[parentId]; parentId = m.parents[parentId] {
+		if parentId == ancestorId {
+			return true
+		}
+		visited[parentId] = true
+	}
+	return false
+}
+
+// keepMachinesInStaleGroups moves machines back into the stale group they were in during the last
+// cycle, if they are now only found in one of its parents. This happens if a group fails after a
+// restart, when no data of the last cycle is available, and would place the assets below the parent.
+func keepMachinesInStaleGroups(config apiserver.Configuration, eliGroups []eliona.MachineGroup, membership groupMembership) ([]eliona.MachineGroup, error) {
+	staleIndexes := make(map[string]int)
+	for i, eliGroup := range eliGroups {
+		if eliGroup.Stale {
+			staleIndexes[eliGroup.GroupID] = i
+		}
+	}
+	if len(staleIndexes) == 0 {
+		return eliGroups, nil
+	}
+	previousGroupIds, err := conf.GetMachineGroupIds(context.Background(), *config.Id)
+	if err != nil {
+		return nil, fmt.Errorf("getting groups of the last cycle: %w", err)
+	}
+	for i := range eliGroups {
+		var machines []eliona.Machine
+		for _, machine := range eliGroups[i].Machines {
+			previousGroupId := previousGroupIds[machine.SerialNumber]
+			staleIndex, stale := staleIndexes[previousGroupId]
+			if stale && previousGroupId != eliGroups[i].GroupID && membership.isAncestor(eliGroups[i].GroupID, previousGroupId) {
+				eliGroups[staleIndex].Machines = append(eliGroups[staleIndex].Machines, machine)
+				continue
 			}
+			machines = append(machines, machine)
+		}
+		eliGroups[i].Machines = machines
+	}
+	return eliGroups, nil
+}
 
-			shouldUse, err = eliona.AdheresToFilter(eliMachine, config.AssetFilter)
+// staleGroup returns the group with the machines of the last cycle marked as stale.
+func staleGroup(eliGroup eliona.MachineGroup, cached eliona.MachineGroup) *eliona.MachineGroup {
+	eliGroup.Stale = true
+	for _, machine := range cached.Machines {
+		machine.Stale = true
+		machine.Errors = nil
+		eliGroup.Machines = append(eliGroup.Machines, machine)
+	}
+	return &eliGroup
+}
+
+func findMachine(eliGroup eliona.MachineGroup, serialNumber string) (eliona.Machine, bool) {
+	for _, machine := range eliGroup.Machines {
+		if machine.SerialNumber == serialNumber {
+			return machine, true
+		}
+	}
+	return eliona.Machine{}, false
+}
+
+var lastGroups = make(map[int64]map[string]eliona.MachineGroup)
+var lastGroupsMutex sync.Mutex
+
+// cacheGroups keeps the completely collected groups of the configuration, so that the data of
+// the last cycle is available if collecting a group fails.
+func cacheGroups(configId int64, eliGroups []eliona.MachineGroup) {
+	lastGroupsMutex.Lock()
+	defer lastGroupsMutex.Unlock()
+	if lastGroups[configId] == nil {
+		lastGroups[configId] = make(map[string]eliona.MachineGroup)
+	}
+	for _, eliGroup := range eliGroups {
+		if eliGroup.Stale {
+			continue
+		}
+		eliGroup.Machines = append([]eliona.Machine(nil), eliGroup.Machines...)
+		lastGroups[configId][eliGroup.GroupID] = eliGroup
+	}
+}
+
+func cachedGroup(configId int64, groupId string) (eliona.MachineGroup, bool) {
+	lastGroupsMutex.Lock()
+	defer lastGroupsMutex.Unlock()
+	eliGroup, exists := lastGroups[configId][groupId]
+	return eliGroup, exists
+}
+
+// detectCleanings detects the cleanings of the collected machines. It is done after the groups are
+// arranged, so that each machine is handled once, even if it is listed in several groups.
+func detectCleanings(config apiserver.Configuration, eliGroups []eliona.MachineGroup) error {
+	for i := range eliGroups {
+		for j := range eliGroups[i].Machines {
+			machine := &eliGroups[i].Machines[j]
+			cleanings, err := conf.DetectCleaning(context.Background(), *config.Id, machine.SerialNumber, machine.HoursSinceCleaned, time.Now())
 			if err != nil {
-				return eliGroups, fmt.Errorf("filtering machine %s: %w", eliMachine.MachineName, err)
+				return fmt.Errorf("detecting cleaning of machine %s: %w", machine.MachineName, err)
 			}
-			if !shouldUse {
-				continue
+			machine.LastCleanedAt = cleanings.LastCleanedAt
+			machine.CleaningsCount = cleanings.Count
+		}
+	}
+	return nil
+}
+
+func serialNumbers(ccMachines map[string]coffeecloud.CoffeeMachine) []string {
+	var serialNumbers []string
+	for serialNumber := range ccMachines {
+		serialNumbers = append(serialNumbers, serialNumber)
+	}
+	return serialNumbers
+}
+
+// latestMachineError returns the latest of the fetched errors (latest first) or, if nothing
+// newer was fetched, the latest stored error.
+func latestMachineError(fetched []eliona.MachineError, stored eliona.MachineError) (eliona.MachineError, bool) {
+	if len(fetched) > 0 && !fetched[0].Timestamp.Before(stored.Timestamp) {
+		return fetched[0], true
+	}
+	return stored, !stored.Timestamp.IsZero()
+}
+
+// machineLocation returns the GPS position reported by the machine's relay, or nil if the
+// position is unknown. The relay reports the position as [longitude, latitude].
+func machineLocation(ccMachine coffeecloud.CoffeeMachine) *eliona.Location {
+	if len(ccMachine.Relay.Location) != 2 {
+		return nil
+	}
+	return &eliona.Location{
+		Latitude:  ccMachine.Relay.Location[1],
+		Longitude: ccMachine.Relay.Location[0],
+	}
+}
+
+// arrangeGroupHierarchy attaches each group to its closest collected ancestor. Machines found
+// in several groups are kept only in the deepest group.
+func arrangeGroupHierarchy(ccGroups []coffeecloud.CoffeeGroup, eliGroups []eliona.MachineGroup) []eliona.MachineGroup {
+	parents := make(map[string]string)
+	depths := make(map[string]int)
+	for _, ccGroup := range ccGroups {
+		groupId := strconv.Itoa(int(ccGroup.ID))
+		if ccGroup.ParentID != nil {
+			parents[groupId] = strconv.Itoa(int(*ccGroup.ParentID))
+		}
+		depths[groupId] = ccGroup.Depth
+	}
+	collected := make(map[string]bool)
+	for _, eliGroup := range eliGroups {
+		collected[eliGroup.GroupID] = true
+	}
+
+	deepestGroups := make(map[string]string)
+	for i, eliGroup := range eliGroups {
+		parentId, visited := parents[eliGroup.GroupID], map[string]bool{eliGroup.GroupID: true}
+		for parentId != "" && !collected[parentId] && !visited[parentId] {
+			visited[parentId] = true
+			parentId = parents[parentId]
+		}
+		if collected[parentId] && !visited[parentId] {
+			eliGroups[i].ParentGroupID = parentId
+		}
+		for _, machine := range eliGroup.Machines {
+			deepestGroupId, exists := deepestGroups[machine.MachineID]
+			if !exists || depths[eliGroup.GroupID] > depths[deepestGroupId] {
+				deepestGroups[machine.MachineID] = eliGroup.GroupID
 			}
+		}
+	}
 
-			eliGroup.Machines = append(eliGroup.Machines, eliMachine)
+	for i, eliGroup := range eliGroups {
+		var machines []eliona.Machine
+		for _, machine := range eliGroup.Machines {
+			if deepestGroups[machine.MachineID] == eliGroup.GroupID {
+				machines = append(machines, machine)
+			}
 		}
-		eliGroups = append(eliGroups, eliGroup)
+		eliGroups[i].Machines = machines
 	}
-	return eliGroups, nil
+	return eliGroups
+}
+
+var clients = make(map[int64]*coffeecloud.Client)
+var clientsMutex sync.Mutex
+
+// coffeeCloudClient returns the client for the configuration. The client is kept between the
+// collection cycles to reuse the auth token and is only replaced if the access data changes.
+func coffeeCloudClient(config apiserver.Configuration) (*coffeecloud.Client, error) {
+	password, apiKey, err := conf.Credentials(config)
+	if err != nil {
+		return nil, fmt.Errorf("getting credentials: %w", err)
+	}
+	clientsMutex.Lock()
+	defer clientsMutex.Unlock()
+	timeout := time.Duration(*config.RequestTimeout) * time.Second
+	client, exists := clients[*config.Id]
+	if !exists || !client.Matches(config.Url, apiKey, config.Username, password, timeout) {
+		client = coffeecloud.NewClient(config.Url, apiKey, config.Username, password, timeout)
+		clients[*config.Id] = client
+	}
+	return client, nil
+}
+
+var dataCaches = make(map[int64]*eliona.DataCache)
+var dataCachesMutex sync.Mutex
+
+// dataCache returns the cache of the data sent for the configuration.
+func dataCache(config apiserver.Configuration) *eliona.DataCache {
+	dataCachesMutex.Lock()
+	defer dataCachesMutex.Unlock()
+	cache, exists := dataCaches[*config.Id]
+	if !exists {
+		cache = eliona.NewDataCache(heartbeatCycles(config))
+		dataCaches[*config.Id] = cache
+	}
+	cache.SetHeartbeat(heartbeatCycles(config))
+	return cache
 }
 
-func createAssetFirstTime(configId int64, projectId string, identifier string, parentId *int32, assetType string, name string) (int32, error) {
-	uniqueIdentifier := assetType + "_" + identifier
+// defaultHeartbeatCycles is used if the configuration does not define the heartbeat.
+const defaultHeartbeatCycles = 10
+
+func heartbeatCycles(config apiserver.Configuration) int {
+	if config.HeartbeatCycles == nil || *config.HeartbeatCycles < 1 {
+		return defaultHeartbeatCycles
+	}
+	return int(*config.HeartbeatCycles)
+}
+
+// assetIdentifier returns the global asset identifier, namespaced by the asset type.
+func assetIdentifier(assetType string, identifier string) string {
+	return assetType + "_" + identifier
+}
+
+func createOrUpdateAsset(config apiserver.Configuration, projectId string, identifier string, parentId *int32, assetType string, name string, location *eliona.Location) (int32, error) {
+	uniqueIdentifier := assetIdentifier(assetType, identifier)
 	ctx := context.Background()
+	state := conf.AssetState{
+		Name:        name,
+		Description: eliona.AssetDescription(name, uniqueIdentifier),
+		ParentId:    parentId,
+		Location:    location,
+	}
 
 	// check if asset already exists in app
-	assetId, err := conf.GetAssetId(ctx, configId, projectId, uniqueIdentifier)
+	dbAsset, err := conf.GetAsset(ctx, *config.Id, projectId, uniqueIdentifier)
 	if err != nil {
-		return 0, fmt.Errorf("get asset id for %s in app: %w", uniqueIdentifier, err)
+		return 0, fmt.Errorf("get asset for %s in app: %w", uniqueIdentifier, err)
 	}
 
 	// if not, create asset in Eliona also
-	if assetId == nil {
+	if dbAsset == nil {
 
 		log.Debug("assets", "no asset id found for %s", uniqueIdentifier)
-		assetId, err = eliona.UpsertAsset(projectId, uniqueIdentifier, parentId, assetType, name)
+		assetId, err := eliona.UpsertAsset(projectId, uniqueIdentifier, parentId, assetType, name, location)
 		if err != nil || assetId == nil {
 			return 0, fmt.Errorf("upserting root asset %s in Eliona: %w", uniqueIdentifier, err)
 		}
 
-		err = conf.InsertAsset(ctx, configId, *assetId, projectId, uniqueIdentifier)
+		err = conf.InsertAsset(ctx, *config.Id, *assetId, projectId, uniqueIdentifier, state)
 		if err != nil {
 			return 0, fmt.Errorf("insert asset %s in app: %w", uniqueIdentifier, err)
 		}
 		log.Debug("assets", "asset created for %s with id %d", uniqueIdentifier, *assetId)
+		return *assetId, nil
+	}
 
+	// if the asset was removed before or has changed since the last sync, update the asset in Eliona
+	if dbAsset.RemovedAt.Valid || conf.AssetChanged(dbAsset, state) {
+		elionaName, err := assetName(config, dbAsset, name)
+		if err != nil {
+			return 0, fmt.Errorf("getting name of asset %s: %w", uniqueIdentifier, err)
+		}
+		_, err = eliona.UpsertAsset(projectId, uniqueIdentifier, parentId, assetType, elionaName, location)
+		if err != nil {
+			return 0, fmt.Errorf("updating asset %s in Eliona: %w", uniqueIdentifier, err)
+		}
+		err = conf.UpdateAsset(ctx, dbAsset, state)
+		if err != nil {
+			return 0, fmt.Errorf("update asset %s in app: %w", uniqueIdentifier, err)
+		}
+		if dbAsset.RemovedAt.Valid {
+			err = conf.SetAssetRemoved(ctx, dbAsset, nil)
+			if err != nil {
+				return 0, fmt.Errorf("restore asset %s in app: %w", uniqueIdentifier, err)
+			}
+			log.Info("assets", "asset %s with id %d is present again", uniqueIdentifier, dbAsset.AssetID.Int32)
+		}
+		log.Debug("assets", "asset updated for %s with id %d", uniqueIdentifier, dbAsset.AssetID.Int32)
 	} else {
-		log.Debug("assets", "asset already created for %s with id %d", uniqueIdentifier, *assetId)
+		log.Debug("assets", "asset already created for %s with id %d", uniqueIdentifier, dbAsset.AssetID.Int32)
 	}
 
-	return *assetId, nil
+	return dbAsset.AssetID.Int32, nil
+}
+
+// assetName returns the name to send to Eliona. If configured, a name changed manually in Eliona
+// takes precedence over the name from CoffeeCloud. A name is considered changed manually if it
+// differs from the name last synced.
+func assetName(config apiserver.Configuration, dbAsset *appdb.Asset, name string) (string, error) {
+	if !common.Val(config.PreferElionaNames) {
+		return name, nil
+	}
+	elionaName, err := eliona.GetAssetName(dbAsset.AssetID.Int32)
+	if err != nil {
+		return "", err
+	}
+	if elionaName != "" && (!dbAsset.Name.Valid || elionaName != dbAsset.Name.String) {
+		log.Debug("assets", "keeping name %s changed in Eliona for %s", elionaName, dbAsset.Identifier)
+		return elionaName, nil
+	}
+	return name, nil
 }
 
 func listenApi() {
 	err := http.ListenAndServe(":"+common.Getenv("API_SERVER_PORT", "3000"), utilshttp.NewCORSEnabledHandler(
 		apiserver.NewRouter(
-			apiserver.NewConfigurationAPIController(apiservices.NewConfigurationApiService()),
+			apiserver.NewConfigurationAPIController(apiservices.NewConfigurationApiService(previewFilter)),
 			apiserver.NewVersionAPIController(apiservices.NewVersionApiService()),
 			apiserver.NewCustomizationAPIController(apiservices.NewCustomizationApiService()),
 		)),
@@ -1,4 +1,4 @@
-// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.17.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package appdb
@@ -24,11 +24,17 @@ import (
 
 // Asset is an object representing the database table.
 type Asset struct {
-	ID              int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	ConfigurationID int64      `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
-	ProjectID       string     `boil:"project_id" json:"project_id" toml:"project_id" yaml:"project_id"`
-	Identifier      string     `boil:"identifier" json:"identifier" toml:"identifier" yaml:"identifier"`
-	AssetID         null.Int32 `boil:"asset_id" json:"asset_id,omitempty" toml:"asset_id" yaml:"asset_id,omitempty"`
+	ID              int64        `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID int64        `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	ProjectID       string       `boil:"project_id" json:"project_id" toml:"project_id" yaml:"project_id"`
+	Identifier      string       `boil:"identifier" json:"identifier" toml:"identifier" yaml:"identifier"`
+	AssetID         null.Int32   `boil:"asset_id" json:"asset_id,omitempty" toml:"asset_id" yaml:"asset_id,omitempty"`
+	Latitude        null.Float64 `boil:"latitude" json:"latitude,omitempty" toml:"latitude" yaml:"latitude,omitempty"`
+	Longitude       null.Float64 `boil:"longitude" json:"longitude,omitempty" toml:"longitude" yaml:"longitude,omitempty"`
+	RemovedAt       null.Time    `boil:"removed_at" json:"removed_at,omitempty" toml:"removed_at" yaml:"removed_at,omitempty"`
+	Name            null.String  `boil:"name" json:"name,omitempty" toml:"name" yaml:"name,omitempty"`
+	Description     null.String  `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	ParentAssetID   null.Int32   `boil:"parent_asset_id" json:"parent_asset_id,omitempty" toml:"parent_asset_id" yaml:"parent_asset_id,omitempty"`
 
 	R *assetR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L assetL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -40,12 +46,24 @@ var AssetColumns = struct {
 	ProjectID       string
 	Identifier      string
 	AssetID         string
+	Latitude        string
+	Longitude       string
+	RemovedAt       string
+	Name            string
+	Description     string
+	ParentAssetID   string
 }{
 	ID:              "id",
 	ConfigurationID: "configuration_id",
 	ProjectID:       "project_id",
 	Identifier:      "identifier",
 	AssetID:         "asset_id",
+	Latitude:        "latitude",
+	Longitude:       "longitude",
+	RemovedAt:       "removed_at",
+	Name:            "name",
+	Description:     "description",
+	ParentAssetID:   "parent_asset_id",
 }
 
 var AssetTableColumns = struct {
@@ -54,12 +72,24 @@ var AssetTableColumns = struct {
 	ProjectID       string
 	Identifier      string
 	AssetID         string
+	Latitude        string
+	Longitude       string
+	RemovedAt       string
+	Name            string
+	Description     string
+	ParentAssetID   string
 }{
 	ID:              "asset.id",
 	ConfigurationID: "asset.configuration_id",
 	ProjectID:       "asset.project_id",
 	Identifier:      "asset.identifier",
 	AssetID:         "asset.asset_id",
+	Latitude:        "asset.latitude",
+	Longitude:       "asset.longitude",
+	RemovedAt:       "asset.removed_at",
+	Name:            "asset.name",
+	Description:     "asset.description",
+	ParentAssetID:   "asset.parent_asset_id",
 }
 
 // Generated where
@@ -89,16 +119,20 @@ func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
 
 type whereHelperstring struct{ field string }
 
-func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) EQ(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod    { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod   { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) ILIKE(x string) qm.QueryMod   { return qm.Where(w.field+" ILIKE ?", x) }
+func (w whereHelperstring) NILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) SIMILAR(x string) qm.QueryMod { return qm.Where(w.field+" SIMILAR TO ?", x) }
+func (w whereHelperstring) NSIMILAR(x string) qm.QueryMod {
+	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
+}
 func (w whereHelperstring) IN(slice []string) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
@@ -152,18 +186,148 @@ func (w whereHelpernull_Int32) NIN(slice []int32) qm.QueryMod {
 func (w whereHelpernull_Int32) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_Int32) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
+type whereHelpernull_Float64 struct{ field string }
+
+func (w whereHelpernull_Float64) EQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Float64) NEQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Float64) LT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Float64) LTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Float64) GT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Float64) GTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Float64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Float64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Float64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Float64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" ILIKE ?", x)
+}
+func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT ILIKE ?", x)
+}
+func (w whereHelpernull_String) SIMILAR(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" SIMILAR TO ?", x)
+}
+func (w whereHelpernull_String) NSIMILAR(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var AssetWhere = struct {
 	ID              whereHelperint64
 	ConfigurationID whereHelperint64
 	ProjectID       whereHelperstring
 	Identifier      whereHelperstring
 	AssetID         whereHelpernull_Int32
+	Latitude        whereHelpernull_Float64
+	Longitude       whereHelpernull_Float64
+	RemovedAt       whereHelpernull_Time
+	Name            whereHelpernull_String
+	Description     whereHelpernull_String
+	ParentAssetID   whereHelpernull_Int32
 }{
 	ID:              whereHelperint64{field: "\"coffeecloud\".\"asset\".\"id\""},
 	ConfigurationID: whereHelperint64{field: "\"coffeecloud\".\"asset\".\"configuration_id\""},
 	ProjectID:       whereHelperstring{field: "\"coffeecloud\".\"asset\".\"project_id\""},
 	Identifier:      whereHelperstring{field: "\"coffeecloud\".\"asset\".\"identifier\""},
 	AssetID:         whereHelpernull_Int32{field: "\"coffeecloud\".\"asset\".\"asset_id\""},
+	Latitude:        whereHelpernull_Float64{field: "\"coffeecloud\".\"asset\".\"latitude\""},
+	Longitude:       whereHelpernull_Float64{field: "\"coffeecloud\".\"asset\".\"longitude\""},
+	RemovedAt:       whereHelpernull_Time{field: "\"coffeecloud\".\"asset\".\"removed_at\""},
+	Name:            whereHelpernull_String{field: "\"coffeecloud\".\"asset\".\"name\""},
+	Description:     whereHelpernull_String{field: "\"coffeecloud\".\"asset\".\"description\""},
+	ParentAssetID:   whereHelpernull_Int32{field: "\"coffeecloud\".\"asset\".\"parent_asset_id\""},
 }
 
 // AssetRels is where relationship names are stored.
@@ -194,9 +358,9 @@ func (r *assetR) GetConfiguration() *Configuration {
 type assetL struct{}
 
 var (
-	assetAllColumns            = []string{"id", "configuration_id", "project_id", "identifier", "asset_id"}
+	assetAllColumns            = []string{"id", "configuration_id", "project_id", "identifier", "asset_id", "latitude", "longitude", "removed_at", "name", "description", "parent_asset_id"}
 	assetColumnsWithoutDefault = []string{"project_id", "identifier"}
-	assetColumnsWithDefault    = []string{"id", "configuration_id", "asset_id"}
+	assetColumnsWithDefault    = []string{"id", "configuration_id", "asset_id", "latitude", "longitude", "removed_at", "name", "description", "parent_asset_id"}
 	assetPrimaryKeyColumns     = []string{"id"}
 	assetGeneratedColumns      = []string{}
 )
@@ -234,18 +398,27 @@ var (
 	_ = qmhelper.Where
 )
 
+var assetAfterSelectMu sync.Mutex
 var assetAfterSelectHooks []AssetHook
 
+var assetBeforeInsertMu sync.Mutex
 var assetBeforeInsertHooks []AssetHook
+var assetAfterInsertMu sync.Mutex
 var assetAfterInsertHooks []AssetHook
 
+var assetBeforeUpdateMu sync.Mutex
 var assetBeforeUpdateHooks []AssetHook
+var assetAfterUpdateMu sync.Mutex
 var assetAfterUpdateHooks []AssetHook
 
+var assetBeforeDeleteMu sync.Mutex
 var assetBeforeDeleteHooks []AssetHook
+var assetAfterDeleteMu sync.Mutex
 var assetAfterDeleteHooks []AssetHook
 
+var assetBeforeUpsertMu sync.Mutex
 var assetBeforeUpsertHooks []AssetHook
+var assetAfterUpsertMu sync.Mutex
 var assetAfterUpsertHooks []AssetHook
 
 // doAfterSelectHooks executes all "after Select" hooks.
@@ -387,23 +560,41 @@ func (o *Asset) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecuto
 func AddAssetHook(hookPoint boil.HookPoint, assetHook AssetHook) {
 	switch hookPoint {
 	case boil.AfterSelectHook:
+		assetAfterSelectMu.Lock()
 		assetAfterSelectHooks = append(assetAfterSelectHooks, assetHook)
+		assetAfterSelectMu.Unlock()
 	case boil.BeforeInsertHook:
+		assetBeforeInsertMu.Lock()
 		assetBeforeInsertHooks = append(assetBeforeInsertHooks, assetHook)
+		assetBeforeInsertMu.Unlock()
 	case boil.AfterInsertHook:
+		assetAfterInsertMu.Lock()
 		assetAfterInsertHooks = append(assetAfterInsertHooks, assetHook)
+		assetAfterInsertMu.Unlock()
 	case boil.BeforeUpdateHook:
+		assetBeforeUpdateMu.Lock()
 		assetBeforeUpdateHooks = append(assetBeforeUpdateHooks, assetHook)
+		assetBeforeUpdateMu.Unlock()
 	case boil.AfterUpdateHook:
+		assetAfterUpdateMu.Lock()
 		assetAfterUpdateHooks = append(assetAfterUpdateHooks, assetHook)
+		assetAfterUpdateMu.Unlock()
 	case boil.BeforeDeleteHook:
+		assetBeforeDeleteMu.Lock()
 		assetBeforeDeleteHooks = append(assetBeforeDeleteHooks, assetHook)
+		assetBeforeDeleteMu.Unlock()
 	case boil.AfterDeleteHook:
+		assetAfterDeleteMu.Lock()
 		assetAfterDeleteHooks = append(assetAfterDeleteHooks, assetHook)
+		assetAfterDeleteMu.Unlock()
 	case boil.BeforeUpsertHook:
+		assetBeforeUpsertMu.Lock()
 		assetBeforeUpsertHooks = append(assetBeforeUpsertHooks, assetHook)
+		assetBeforeUpsertMu.Unlock()
 	case boil.AfterUpsertHook:
+		assetAfterUpsertMu.Lock()
 		assetAfterUpsertHooks = append(assetAfterUpsertHooks, assetHook)
+		assetAfterUpsertMu.Unlock()
 	}
 }
 
@@ -538,27 +729,20 @@ func (assetL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, sin
 		}
 	}
 
-	args := make([]interface{}, 0, 1)
+	args := make(map[interface{}]struct{})
 	if singular {
 		if object.R == nil {
 			object.R = &assetR{}
 		}
-		args = append(args, object.ConfigurationID)
+		args[object.ConfigurationID] = struct{}{}
 
 	} else {
-	Outer:
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &assetR{}
 			}
 
-			for _, a := range args {
-				if a == obj.ConfigurationID {
-					continue Outer
-				}
-			}
-
-			args = append(args, obj.ConfigurationID)
+			args[obj.ConfigurationID] = struct{}{}
 
 		}
 	}
@@ -567,9 +751,16 @@ func (assetL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, sin
 		return nil
 	}
 
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
 	query := NewQuery(
 		qm.From(`coffeecloud.configuration`),
-		qm.WhereIn(`coffeecloud.configuration.id in ?`, args...),
+		qm.WhereIn(`coffeecloud.configuration.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -960,13 +1151,13 @@ func (o AssetSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, co
 }
 
 // UpsertG attempts an insert, and does an update or ignore on conflict.
-func (o *Asset) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
-	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+func (o *Asset) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
 }
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *Asset) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+func (o *Asset) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
 	if o == nil {
 		return errors.New("appdb: no asset provided for upsert")
 	}
@@ -1012,7 +1203,7 @@ func (o *Asset) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnC
 	var err error
 
 	if !cached {
-		insert, ret := insertColumns.InsertColumnSet(
+		insert, _ := insertColumns.InsertColumnSet(
 			assetAllColumns,
 			assetColumnsWithDefault,
 			assetColumnsWithoutDefault,
@@ -1028,12 +1219,18 @@ func (o *Asset) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnC
 			return errors.New("appdb: unable to upsert asset, could not build update column list")
 		}
 
+		ret := strmangle.SetComplement(assetAllColumns, strmangle.SetIntersect(insert, update))
+
 		conflict := conflictColumns
-		if len(conflict) == 0 {
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(assetPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert asset, could not build conflict column list")
+			}
+
 			conflict = make([]string, len(assetPrimaryKeyColumns))
 			copy(conflict, assetPrimaryKeyColumns)
 		}
-		cache.query = buildUpsertQueryPostgres(dialect, "\"coffeecloud\".\"asset\"", updateOnConflict, ret, update, conflict, insert)
+		cache.query = buildUpsertQueryPostgres(dialect, "\"coffeecloud\".\"asset\"", updateOnConflict, ret, update, conflict, insert, opts...)
 
 		cache.valueMapping, err = queries.BindMapping(assetType, assetMapping, insert)
 		if err != nil {
@@ -1173,7 +1370,7 @@ func (o AssetSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (i
 	}
 
 	sql := "DELETE FROM \"coffeecloud\".\"asset\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assetPrimaryKeyColumns, len(o))
+		strmangle.WhereInClause(string(dialect.LQ), string(dialect.RQ), 1, assetPrimaryKeyColumns, len(o))
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
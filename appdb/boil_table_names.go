@@ -1,12 +1,22 @@
-// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.17.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package appdb
 
 var TableNames = struct {
-	Asset         string
-	Configuration string
+	Asset              string
+	Configuration      string
+	MachineCleaning    string
+	MachineError       string
+	MachineErrorCursor string
+	MachineMove        string
+	MachineState       string
 }{
-	Asset:         "asset",
-	Configuration: "configuration",
+	Asset:              "asset",
+	Configuration:      "configuration",
+	MachineCleaning:    "machine_cleaning",
+	MachineError:       "machine_error",
+	MachineErrorCursor: "machine_error_cursor",
+	MachineMove:        "machine_move",
+	MachineState:       "machine_state",
 }
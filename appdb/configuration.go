@@ -1,4 +1,4 @@
-// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// Code generated by SQLBoiler 4.17.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
 // This file is meant to be re-generated in place and/or deleted at any time.
 
 package appdb
@@ -25,72 +25,97 @@ import (
 
 // Configuration is an object representing the database table.
 type Configuration struct {
-	ID              int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Username        string            `boil:"username" json:"username" toml:"username" yaml:"username"`
-	Password        string            `boil:"password" json:"password" toml:"password" yaml:"password"`
-	APIKey          string            `boil:"api_key" json:"api_key" toml:"api_key" yaml:"api_key"`
-	URL             string            `boil:"url" json:"url" toml:"url" yaml:"url"`
-	RefreshInterval int32             `boil:"refresh_interval" json:"refresh_interval" toml:"refresh_interval" yaml:"refresh_interval"`
-	RequestTimeout  int32             `boil:"request_timeout" json:"request_timeout" toml:"request_timeout" yaml:"request_timeout"`
-	AssetFilter     null.JSON         `boil:"asset_filter" json:"asset_filter,omitempty" toml:"asset_filter" yaml:"asset_filter,omitempty"`
-	Active          null.Bool         `boil:"active" json:"active,omitempty" toml:"active" yaml:"active,omitempty"`
-	Enable          null.Bool         `boil:"enable" json:"enable,omitempty" toml:"enable" yaml:"enable,omitempty"`
-	ProjectIds      types.StringArray `boil:"project_ids" json:"project_ids,omitempty" toml:"project_ids" yaml:"project_ids,omitempty"`
+	ID                 int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Username           string            `boil:"username" json:"username" toml:"username" yaml:"username"`
+	Password           string            `boil:"password" json:"password" toml:"password" yaml:"password"`
+	APIKey             string            `boil:"api_key" json:"api_key" toml:"api_key" yaml:"api_key"`
+	URL                string            `boil:"url" json:"url" toml:"url" yaml:"url"`
+	RefreshInterval    int32             `boil:"refresh_interval" json:"refresh_interval" toml:"refresh_interval" yaml:"refresh_interval"`
+	RequestTimeout     int32             `boil:"request_timeout" json:"request_timeout" toml:"request_timeout" yaml:"request_timeout"`
+	AssetFilter        null.JSON         `boil:"asset_filter" json:"asset_filter,omitempty" toml:"asset_filter" yaml:"asset_filter,omitempty"`
+	Active             null.Bool         `boil:"active" json:"active,omitempty" toml:"active" yaml:"active,omitempty"`
+	Enable             null.Bool         `boil:"enable" json:"enable,omitempty" toml:"enable" yaml:"enable,omitempty"`
+	ProjectIds         types.StringArray `boil:"project_ids" json:"project_ids,omitempty" toml:"project_ids" yaml:"project_ids,omitempty"`
+	RequestsPerMinute  null.Int32        `boil:"requests_per_minute" json:"requests_per_minute,omitempty" toml:"requests_per_minute" yaml:"requests_per_minute,omitempty"`
+	ConcurrentGroups   null.Int32        `boil:"concurrent_groups" json:"concurrent_groups,omitempty" toml:"concurrent_groups" yaml:"concurrent_groups,omitempty"`
+	RemovedAssetPolicy null.String       `boil:"removed_asset_policy" json:"removed_asset_policy,omitempty" toml:"removed_asset_policy" yaml:"removed_asset_policy,omitempty"`
+	PreferElionaNames  null.Bool         `boil:"prefer_eliona_names" json:"prefer_eliona_names,omitempty" toml:"prefer_eliona_names" yaml:"prefer_eliona_names,omitempty"`
+	HeartbeatCycles    null.Int32        `boil:"heartbeat_cycles" json:"heartbeat_cycles,omitempty" toml:"heartbeat_cycles" yaml:"heartbeat_cycles,omitempty"`
 
 	R *configurationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L configurationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ConfigurationColumns = struct {
-	ID              string
-	Username        string
-	Password        string
-	APIKey          string
-	URL             string
-	RefreshInterval string
-	RequestTimeout  string
-	AssetFilter     string
-	Active          string
-	Enable          string
-	ProjectIds      string
+	ID                 string
+	Username           string
+	Password           string
+	APIKey             string
+	URL                string
+	RefreshInterval    string
+	RequestTimeout     string
+	AssetFilter        string
+	Active             string
+	Enable             string
+	ProjectIds         string
+	RequestsPerMinute  string
+	ConcurrentGroups   string
+	RemovedAssetPolicy string
+	PreferElionaNames  string
+	HeartbeatCycles    string
 }{
-	ID:              "id",
-	Username:        "username",
-	Password:        "password",
-	APIKey:          "api_key",
-	URL:             "url",
-	RefreshInterval: "refresh_interval",
-	RequestTimeout:  "request_timeout",
-	AssetFilter:     "asset_filter",
-	Active:          "active",
-	Enable:          "enable",
-	ProjectIds:      "project_ids",
+	ID:                 "id",
+	Username:           "username",
+	Password:           "password",
+	APIKey:             "api_key",
+	URL:                "url",
+	RefreshInterval:    "refresh_interval",
+	RequestTimeout:     "request_timeout",
+	AssetFilter:        "asset_filter",
+	Active:             "active",
+	Enable:             "enable",
+	ProjectIds:         "project_ids",
+	RequestsPerMinute:  "requests_per_minute",
+	ConcurrentGroups:   "concurrent_groups",
+	RemovedAssetPolicy: "removed_asset_policy",
+	PreferElionaNames:  "prefer_eliona_names",
+	HeartbeatCycles:    "heartbeat_cycles",
 }
 
 var ConfigurationTableColumns = struct {
-	ID              string
-	Username        string
-	Password        string
-	APIKey          string
-	URL             string
-	RefreshInterval string
-	RequestTimeout  string
-	AssetFilter     string
-	Active          string
-	Enable          string
-	ProjectIds      string
+	ID                 string
+	Username           string
+	Password           string
+	APIKey             string
+	URL                string
+	RefreshInterval    string
+	RequestTimeout     string
+	AssetFilter        string
+	Active             string
+	Enable             string
+	ProjectIds         string
+	RequestsPerMinute  string
+	ConcurrentGroups   string
+	RemovedAssetPolicy string
+	PreferElionaNames  string
+	HeartbeatCycles    string
 }{
-	ID:              "configuration.id",
-	Username:        "configuration.username",
-	Password:        "configuration.password",
-	APIKey:          "configuration.api_key",
-	URL:             "configuration.url",
-	RefreshInterval: "configuration.refresh_interval",
-	RequestTimeout:  "configuration.request_timeout",
-	AssetFilter:     "configuration.asset_filter",
-	Active:          "configuration.active",
-	Enable:          "configuration.enable",
-	ProjectIds:      "configuration.project_ids",
+	ID:                 "configuration.id",
+	Username:           "configuration.username",
+	Password:           "configuration.password",
+	APIKey:             "configuration.api_key",
+	URL:                "configuration.url",
+	RefreshInterval:    "configuration.refresh_interval",
+	RequestTimeout:     "configuration.request_timeout",
+	AssetFilter:        "configuration.asset_filter",
+	Active:             "configuration.active",
+	Enable:             "configuration.enable",
+	ProjectIds:         "configuration.project_ids",
+	RequestsPerMinute:  "configuration.requests_per_minute",
+	ConcurrentGroups:   "configuration.concurrent_groups",
+	RemovedAssetPolicy: "configuration.removed_asset_policy",
+	PreferElionaNames:  "configuration.prefer_eliona_names",
+	HeartbeatCycles:    "configuration.heartbeat_cycles",
 }
 
 // Generated where
@@ -193,41 +218,66 @@ func (w whereHelpertypes_StringArray) IsNotNull() qm.QueryMod {
 }
 
 var ConfigurationWhere = struct {
-	ID              whereHelperint64
-	Username        whereHelperstring
-	Password        whereHelperstring
-	APIKey          whereHelperstring
-	URL             whereHelperstring
-	RefreshInterval whereHelperint32
-	RequestTimeout  whereHelperint32
-	AssetFilter     whereHelpernull_JSON
-	Active          whereHelpernull_Bool
-	Enable          whereHelpernull_Bool
-	ProjectIds      whereHelpertypes_StringArray
+	ID                 whereHelperint64
+	Username           whereHelperstring
+	Password           whereHelperstring
+	APIKey             whereHelperstring
+	URL                whereHelperstring
+	RefreshInterval    whereHelperint32
+	RequestTimeout     whereHelperint32
+	AssetFilter        whereHelpernull_JSON
+	Active             whereHelpernull_Bool
+	Enable             whereHelpernull_Bool
+	ProjectIds         whereHelpertypes_StringArray
+	RequestsPerMinute  whereHelpernull_Int32
+	ConcurrentGroups   whereHelpernull_Int32
+	RemovedAssetPolicy whereHelpernull_String
+	PreferElionaNames  whereHelpernull_Bool
+	HeartbeatCycles    whereHelpernull_Int32
 }{
-	ID:              whereHelperint64{field: "\"coffeecloud\".\"configuration\".\"id\""},
-	Username:        whereHelperstring{field: "\"coffeecloud\".\"configuration\".\"username\""},
-	Password:        whereHelperstring{field: "\"coffeecloud\".\"configuration\".\"password\""},
-	APIKey:          whereHelperstring{field: "\"coffeecloud\".\"configuration\".\"api_key\""},
-	URL:             whereHelperstring{field: "\"coffeecloud\".\"configuration\".\"url\""},
-	RefreshInterval: whereHelperint32{field: "\"coffeecloud\".\"configuration\".\"refresh_interval\""},
-	RequestTimeout:  whereHelperint32{field: "\"coffeecloud\".\"configuration\".\"request_timeout\""},
-	AssetFilter:     whereHelpernull_JSON{field: "\"coffeecloud\".\"configuration\".\"asset_filter\""},
-	Active:          whereHelpernull_Bool{field: "\"coffeecloud\".\"configuration\".\"active\""},
-	Enable:          whereHelpernull_Bool{field: "\"coffeecloud\".\"configuration\".\"enable\""},
-	ProjectIds:      whereHelpertypes_StringArray{field: "\"coffeecloud\".\"configuration\".\"project_ids\""},
+	ID:                 whereHelperint64{field: "\"coffeecloud\".\"configuration\".\"id\""},
+	Username:           whereHelperstring{field: "\"coffeecloud\".\"configuration\".\"username\""},
+	Password:           whereHelperstring{field: "\"coffeecloud\".\"configuration\".\"password\""},
+	APIKey:             whereHelperstring{field: "\"coffeecloud\".\"configuration\".\"api_key\""},
+	URL:                whereHelperstring{field: "\"coffeecloud\".\"configuration\".\"url\""},
+	RefreshInterval:    whereHelperint32{field: "\"coffeecloud\".\"configuration\".\"refresh_interval\""},
+	RequestTimeout:     whereHelperint32{field: "\"coffeecloud\".\"configuration\".\"request_timeout\""},
+	AssetFilter:        whereHelpernull_JSON{field: "\"coffeecloud\".\"configuration\".\"asset_filter\""},
+	Active:             whereHelpernull_Bool{field: "\"coffeecloud\".\"configuration\".\"active\""},
+	Enable:             whereHelpernull_Bool{field: "\"coffeecloud\".\"configuration\".\"enable\""},
+	ProjectIds:         whereHelpertypes_StringArray{field: "\"coffeecloud\".\"configuration\".\"project_ids\""},
+	RequestsPerMinute:  whereHelpernull_Int32{field: "\"coffeecloud\".\"configuration\".\"requests_per_minute\""},
+	ConcurrentGroups:   whereHelpernull_Int32{field: "\"coffeecloud\".\"configuration\".\"concurrent_groups\""},
+	RemovedAssetPolicy: whereHelpernull_String{field: "\"coffeecloud\".\"configuration\".\"removed_asset_policy\""},
+	PreferElionaNames:  whereHelpernull_Bool{field: "\"coffeecloud\".\"configuration\".\"prefer_eliona_names\""},
+	HeartbeatCycles:    whereHelpernull_Int32{field: "\"coffeecloud\".\"configuration\".\"heartbeat_cycles\""},
 }
 
 // ConfigurationRels is where relationship names are stored.
 var ConfigurationRels = struct {
-	Assets string
+	MachineErrorCursor string
+	Assets             string
+	MachineCleanings   string
+	MachineErrors      string
+	MachineMoves       string
+	MachineStates      string
 }{
-	Assets: "Assets",
+	MachineErrorCursor: "MachineErrorCursor",
+	Assets:             "Assets",
+	MachineCleanings:   "MachineCleanings",
+	MachineErrors:      "MachineErrors",
+	MachineMoves:       "MachineMoves",
+	MachineStates:      "MachineStates",
 }
 
 // configurationR is where relationships are stored.
 type configurationR struct {
-	Assets AssetSlice `boil:"Assets" json:"Assets" toml:"Assets" yaml:"Assets"`
+	MachineErrorCursor *MachineErrorCursor  `boil:"MachineErrorCursor" json:"MachineErrorCursor" toml:"MachineErrorCursor" yaml:"MachineErrorCursor"`
+	Assets             AssetSlice           `boil:"Assets" json:"Assets" toml:"Assets" yaml:"Assets"`
+	MachineCleanings   MachineCleaningSlice `boil:"MachineCleanings" json:"MachineCleanings" toml:"MachineCleanings" yaml:"MachineCleanings"`
+	MachineErrors      MachineErrorSlice    `boil:"MachineErrors" json:"MachineErrors" toml:"MachineErrors" yaml:"MachineErrors"`
+	MachineMoves       MachineMoveSlice     `boil:"MachineMoves" json:"MachineMoves" toml:"MachineMoves" yaml:"MachineMoves"`
+	MachineStates      MachineStateSlice    `boil:"MachineStates" json:"MachineStates" toml:"MachineStates" yaml:"MachineStates"`
 }
 
 // NewStruct creates a new relationship struct
@@ -235,6 +285,13 @@ func (*configurationR) NewStruct() *configurationR {
 	return &configurationR{}
 }
 
+func (r *configurationR) GetMachineErrorCursor() *MachineErrorCursor {
+	if r == nil {
+		return nil
+	}
+	return r.MachineErrorCursor
+}
+
 func (r *configurationR) GetAssets() AssetSlice {
 	if r == nil {
 		return nil
@@ -242,13 +299,41 @@ func (r *configurationR) GetAssets() AssetSlice {
 	return r.Assets
 }
 
+func (r *configurationR) GetMachineCleanings() MachineCleaningSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MachineCleanings
+}
+
+func (r *configurationR) GetMachineErrors() MachineErrorSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MachineErrors
+}
+
+func (r *configurationR) GetMachineMoves() MachineMoveSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MachineMoves
+}
+
+func (r *configurationR) GetMachineStates() MachineStateSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MachineStates
+}
+
 // configurationL is where Load methods for each relationship are stored.
 type configurationL struct{}
 
 var (
-	configurationAllColumns            = []string{"id", "username", "password", "api_key", "url", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids"}
+	configurationAllColumns            = []string{"id", "username", "password", "api_key", "url", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids", "requests_per_minute", "concurrent_groups", "removed_asset_policy", "prefer_eliona_names", "heartbeat_cycles"}
 	configurationColumnsWithoutDefault = []string{"username", "password", "api_key", "url"}
-	configurationColumnsWithDefault    = []string{"id", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids"}
+	configurationColumnsWithDefault    = []string{"id", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids", "requests_per_minute", "concurrent_groups", "removed_asset_policy", "prefer_eliona_names", "heartbeat_cycles"}
 	configurationPrimaryKeyColumns     = []string{"id"}
 	configurationGeneratedColumns      = []string{}
 )
@@ -286,18 +371,27 @@ var (
 	_ = qmhelper.Where
 )
 
+var configurationAfterSelectMu sync.Mutex
 var configurationAfterSelectHooks []ConfigurationHook
 
+var configurationBeforeInsertMu sync.Mutex
 var configurationBeforeInsertHooks []ConfigurationHook
+var configurationAfterInsertMu sync.Mutex
 var configurationAfterInsertHooks []ConfigurationHook
 
+var configurationBeforeUpdateMu sync.Mutex
 var configurationBeforeUpdateHooks []ConfigurationHook
+var configurationAfterUpdateMu sync.Mutex
 var configurationAfterUpdateHooks []ConfigurationHook
 
+var configurationBeforeDeleteMu sync.Mutex
 var configurationBeforeDeleteHooks []ConfigurationHook
+var configurationAfterDeleteMu sync.Mutex
 var configurationAfterDeleteHooks []ConfigurationHook
 
+var configurationBeforeUpsertMu sync.Mutex
 var configurationBeforeUpsertHooks []ConfigurationHook
+var configurationAfterUpsertMu sync.Mutex
 var configurationAfterUpsertHooks []ConfigurationHook
 
 // doAfterSelectHooks executes all "after Select" hooks.
@@ -439,23 +533,41 @@ func (o *Configuration) doAfterUpsertHooks(ctx context.Context, exec boil.Contex
 func AddConfigurationHook(hookPoint boil.HookPoint, configurationHook ConfigurationHook) {
 	switch hookPoint {
 	case boil.AfterSelectHook:
+		configurationAfterSelectMu.Lock()
 		configurationAfterSelectHooks = append(configurationAfterSelectHooks, configurationHook)
+		configurationAfterSelectMu.Unlock()
 	case boil.BeforeInsertHook:
+		configurationBeforeInsertMu.Lock()
 		configurationBeforeInsertHooks = append(configurationBeforeInsertHooks, configurationHook)
+		configurationBeforeInsertMu.Unlock()
 	case boil.AfterInsertHook:
+		configurationAfterInsertMu.Lock()
 		configurationAfterInsertHooks = append(configurationAfterInsertHooks, configurationHook)
+		configurationAfterInsertMu.Unlock()
 	case boil.BeforeUpdateHook:
+		configurationBeforeUpdateMu.Lock()
 		configurationBeforeUpdateHooks = append(configurationBeforeUpdateHooks, configurationHook)
+		configurationBeforeUpdateMu.Unlock()
 	case boil.AfterUpdateHook:
+		configurationAfterUpdateMu.Lock()
 		configurationAfterUpdateHooks = append(configurationAfterUpdateHooks, configurationHook)
+		configurationAfterUpdateMu.Unlock()
 	case boil.BeforeDeleteHook:
+		configurationBeforeDeleteMu.Lock()
 		configurationBeforeDeleteHooks = append(configurationBeforeDeleteHooks, configurationHook)
+		configurationBeforeDeleteMu.Unlock()
 	case boil.AfterDeleteHook:
+		configurationAfterDeleteMu.Lock()
 		configurationAfterDeleteHooks = append(configurationAfterDeleteHooks, configurationHook)
+		configurationAfterDeleteMu.Unlock()
 	case boil.BeforeUpsertHook:
+		configurationBeforeUpsertMu.Lock()
 		configurationBeforeUpsertHooks = append(configurationBeforeUpsertHooks, configurationHook)
+		configurationBeforeUpsertMu.Unlock()
 	case boil.AfterUpsertHook:
+		configurationAfterUpsertMu.Lock()
 		configurationAfterUpsertHooks = append(configurationAfterUpsertHooks, configurationHook)
+		configurationAfterUpsertMu.Unlock()
 	}
 }
 
@@ -551,6 +663,17 @@ func (q configurationQuery) Exists(ctx context.Context, exec boil.ContextExecuto
 	return count > 0, nil
 }
 
+// MachineErrorCursor pointed to by the foreign key.
+func (o *Configuration) MachineErrorCursor(mods ...qm.QueryMod) machineErrorCursorQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"configuration_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return MachineErrorCursors(queryMods...)
+}
+
 // Assets retrieves all the asset's Assets with an executor.
 func (o *Configuration) Assets(mods ...qm.QueryMod) assetQuery {
 	var queryMods []qm.QueryMod
@@ -562,12 +685,637 @@ func (o *Configuration) Assets(mods ...qm.QueryMod) assetQuery {
 		qm.Where("\"coffeecloud\".\"asset\".\"configuration_id\"=?", o.ID),
 	)
 
-	return Assets(queryMods...)
+	return Assets(queryMods...)
+}
+
+// MachineCleanings retrieves all the machine_cleaning's MachineCleanings with an executor.
+func (o *Configuration) MachineCleanings(mods ...qm.QueryMod) machineCleaningQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"coffeecloud\".\"machine_cleaning\".\"configuration_id\"=?", o.ID),
+	)
+
+	return MachineCleanings(queryMods...)
+}
+
+// MachineErrors retrieves all the machine_error's MachineErrors with an executor.
+func (o *Configuration) MachineErrors(mods ...qm.QueryMod) machineErrorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"coffeecloud\".\"machine_error\".\"configuration_id\"=?", o.ID),
+	)
+
+	return MachineErrors(queryMods...)
+}
+
+// MachineMoves retrieves all the machine_move's MachineMoves with an executor.
+func (o *Configuration) MachineMoves(mods ...qm.QueryMod) machineMoveQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"coffeecloud\".\"machine_move\".\"configuration_id\"=?", o.ID),
+	)
+
+	return MachineMoves(queryMods...)
+}
+
+// MachineStates retrieves all the machine_state's MachineStates with an executor.
+func (o *Configuration) MachineStates(mods ...qm.QueryMod) machineStateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"coffeecloud\".\"machine_state\".\"configuration_id\"=?", o.ID),
+	)
+
+	return MachineStates(queryMods...)
+}
+
+// LoadMachineErrorCursor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (configurationL) LoadMachineErrorCursor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`coffeecloud.machine_error_cursor`),
+		qm.WhereIn(`coffeecloud.machine_error_cursor.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load MachineErrorCursor")
+	}
+
+	var resultSlice []*MachineErrorCursor
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice MachineErrorCursor")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for machine_error_cursor")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for machine_error_cursor")
+	}
+
+	if len(machineErrorCursorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.MachineErrorCursor = foreign
+		if foreign.R == nil {
+			foreign.R = &machineErrorCursorR{}
+		}
+		foreign.R.Configuration = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.MachineErrorCursor = foreign
+				if foreign.R == nil {
+					foreign.R = &machineErrorCursorR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAssets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadAssets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`coffeecloud.asset`),
+		qm.WhereIn(`coffeecloud.asset.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load asset")
+	}
+
+	var resultSlice []*Asset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice asset")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on asset")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset")
+	}
+
+	if len(assetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Assets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &assetR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.Assets = append(local.R.Assets, foreign)
+				if foreign.R == nil {
+					foreign.R = &assetR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMachineCleanings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadMachineCleanings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`coffeecloud.machine_cleaning`),
+		qm.WhereIn(`coffeecloud.machine_cleaning.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load machine_cleaning")
+	}
+
+	var resultSlice []*MachineCleaning
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice machine_cleaning")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on machine_cleaning")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for machine_cleaning")
+	}
+
+	if len(machineCleaningAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MachineCleanings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &machineCleaningR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.MachineCleanings = append(local.R.MachineCleanings, foreign)
+				if foreign.R == nil {
+					foreign.R = &machineCleaningR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMachineErrors allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadMachineErrors(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`coffeecloud.machine_error`),
+		qm.WhereIn(`coffeecloud.machine_error.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load machine_error")
+	}
+
+	var resultSlice []*MachineError
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice machine_error")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on machine_error")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for machine_error")
+	}
+
+	if len(machineErrorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MachineErrors = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &machineErrorR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.MachineErrors = append(local.R.MachineErrors, foreign)
+				if foreign.R == nil {
+					foreign.R = &machineErrorR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMachineMoves allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadMachineMoves(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`coffeecloud.machine_move`),
+		qm.WhereIn(`coffeecloud.machine_move.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load machine_move")
+	}
+
+	var resultSlice []*MachineMove
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice machine_move")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on machine_move")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for machine_move")
+	}
+
+	if len(machineMoveAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MachineMoves = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &machineMoveR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.MachineMoves = append(local.R.MachineMoves, foreign)
+				if foreign.R == nil {
+					foreign.R = &machineMoveR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
 }
 
-// LoadAssets allows an eager lookup of values, cached into the
+// LoadMachineStates allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (configurationL) LoadAssets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+func (configurationL) LoadMachineStates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
 	var slice []*Configuration
 	var object *Configuration
 
@@ -593,26 +1341,18 @@ func (configurationL) LoadAssets(ctx context.Context, e boil.ContextExecutor, si
 		}
 	}
 
-	args := make([]interface{}, 0, 1)
+	args := make(map[interface{}]struct{})
 	if singular {
 		if object.R == nil {
 			object.R = &configurationR{}
 		}
-		args = append(args, object.ID)
+		args[object.ID] = struct{}{}
 	} else {
-	Outer:
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &configurationR{}
 			}
-
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
-			}
-
-			args = append(args, obj.ID)
+			args[obj.ID] = struct{}{}
 		}
 	}
 
@@ -620,9 +1360,16 @@ func (configurationL) LoadAssets(ctx context.Context, e boil.ContextExecutor, si
 		return nil
 	}
 
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
 	query := NewQuery(
-		qm.From(`coffeecloud.asset`),
-		qm.WhereIn(`coffeecloud.asset.configuration_id in ?`, args...),
+		qm.From(`coffeecloud.machine_state`),
+		qm.WhereIn(`coffeecloud.machine_state.configuration_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -630,22 +1377,22 @@ func (configurationL) LoadAssets(ctx context.Context, e boil.ContextExecutor, si
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load asset")
+		return errors.Wrap(err, "failed to eager load machine_state")
 	}
 
-	var resultSlice []*Asset
+	var resultSlice []*MachineState
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice asset")
+		return errors.Wrap(err, "failed to bind eager loaded slice machine_state")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on asset")
+		return errors.Wrap(err, "failed to close results in eager load on machine_state")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for machine_state")
 	}
 
-	if len(assetAfterSelectHooks) != 0 {
+	if len(machineStateAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -653,10 +1400,10 @@ func (configurationL) LoadAssets(ctx context.Context, e boil.ContextExecutor, si
 		}
 	}
 	if singular {
-		object.R.Assets = resultSlice
+		object.R.MachineStates = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &assetR{}
+				foreign.R = &machineStateR{}
 			}
 			foreign.R.Configuration = object
 		}
@@ -666,9 +1413,9 @@ func (configurationL) LoadAssets(ctx context.Context, e boil.ContextExecutor, si
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ConfigurationID {
-				local.R.Assets = append(local.R.Assets, foreign)
+				local.R.MachineStates = append(local.R.MachineStates, foreign)
 				if foreign.R == nil {
-					foreign.R = &assetR{}
+					foreign.R = &machineStateR{}
 				}
 				foreign.R.Configuration = local
 				break
@@ -679,6 +1426,64 @@ func (configurationL) LoadAssets(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
+// SetMachineErrorCursorG of the configuration to the related item.
+// Sets o.R.MachineErrorCursor to related.
+// Adds o to related.R.Configuration.
+// Uses the global database handle.
+func (o *Configuration) SetMachineErrorCursorG(ctx context.Context, insert bool, related *MachineErrorCursor) error {
+	return o.SetMachineErrorCursor(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetMachineErrorCursor of the configuration to the related item.
+// Sets o.R.MachineErrorCursor to related.
+// Adds o to related.R.Configuration.
+func (o *Configuration) SetMachineErrorCursor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *MachineErrorCursor) error {
+	var err error
+
+	if insert {
+		related.ConfigurationID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"coffeecloud\".\"machine_error_cursor\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+			strmangle.WhereClause("\"", "\"", 2, machineErrorCursorPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ConfigurationID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ConfigurationID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			MachineErrorCursor: related,
+		}
+	} else {
+		o.R.MachineErrorCursor = related
+	}
+
+	if related.R == nil {
+		related.R = &machineErrorCursorR{
+			Configuration: o,
+		}
+	} else {
+		related.R.Configuration = o
+	}
+	return nil
+}
+
 // AddAssetsG adds the given related objects to the existing relationships
 // of the configuration, optionally inserting them as new records.
 // Appends related to o.R.Assets.
@@ -741,6 +1546,254 @@ func (o *Configuration) AddAssets(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
+// AddMachineCleaningsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.MachineCleanings.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddMachineCleaningsG(ctx context.Context, insert bool, related ...*MachineCleaning) error {
+	return o.AddMachineCleanings(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddMachineCleanings adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.MachineCleanings.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddMachineCleanings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MachineCleaning) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"coffeecloud\".\"machine_cleaning\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, machineCleaningPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			MachineCleanings: related,
+		}
+	} else {
+		o.R.MachineCleanings = append(o.R.MachineCleanings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &machineCleaningR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddMachineErrorsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.MachineErrors.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddMachineErrorsG(ctx context.Context, insert bool, related ...*MachineError) error {
+	return o.AddMachineErrors(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddMachineErrors adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.MachineErrors.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddMachineErrors(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MachineError) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"coffeecloud\".\"machine_error\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, machineErrorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			MachineErrors: related,
+		}
+	} else {
+		o.R.MachineErrors = append(o.R.MachineErrors, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &machineErrorR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddMachineMovesG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.MachineMoves.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddMachineMovesG(ctx context.Context, insert bool, related ...*MachineMove) error {
+	return o.AddMachineMoves(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddMachineMoves adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.MachineMoves.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddMachineMoves(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MachineMove) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"coffeecloud\".\"machine_move\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, machineMovePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			MachineMoves: related,
+		}
+	} else {
+		o.R.MachineMoves = append(o.R.MachineMoves, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &machineMoveR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddMachineStatesG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.MachineStates.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddMachineStatesG(ctx context.Context, insert bool, related ...*MachineState) error {
+	return o.AddMachineStates(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddMachineStates adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.MachineStates.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddMachineStates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MachineState) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"coffeecloud\".\"machine_state\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, machineStatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			MachineStates: related,
+		}
+	} else {
+		o.R.MachineStates = append(o.R.MachineStates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &machineStateR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
 // Configurations retrieves all the records using an executor.
 func Configurations(mods ...qm.QueryMod) configurationQuery {
 	mods = append(mods, qm.From("\"coffeecloud\".\"configuration\""))
@@ -1016,13 +2069,13 @@ func (o ConfigurationSlice) UpdateAll(ctx context.Context, exec boil.ContextExec
 }
 
 // UpsertG attempts an insert, and does an update or ignore on conflict.
-func (o *Configuration) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
-	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+func (o *Configuration) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
 }
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *Configuration) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+func (o *Configuration) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
 	if o == nil {
 		return errors.New("appdb: no configuration provided for upsert")
 	}
@@ -1068,7 +2121,7 @@ func (o *Configuration) Upsert(ctx context.Context, exec boil.ContextExecutor, u
 	var err error
 
 	if !cached {
-		insert, ret := insertColumns.InsertColumnSet(
+		insert, _ := insertColumns.InsertColumnSet(
 			configurationAllColumns,
 			configurationColumnsWithDefault,
 			configurationColumnsWithoutDefault,
@@ -1084,12 +2137,18 @@ func (o *Configuration) Upsert(ctx context.Context, exec boil.ContextExecutor, u
 			return errors.New("appdb: unable to upsert configuration, could not build update column list")
 		}
 
+		ret := strmangle.SetComplement(configurationAllColumns, strmangle.SetIntersect(insert, update))
+
 		conflict := conflictColumns
-		if len(conflict) == 0 {
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(configurationPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert configuration, could not build conflict column list")
+			}
+
 			conflict = make([]string, len(configurationPrimaryKeyColumns))
 			copy(conflict, configurationPrimaryKeyColumns)
 		}
-		cache.query = buildUpsertQueryPostgres(dialect, "\"coffeecloud\".\"configuration\"", updateOnConflict, ret, update, conflict, insert)
+		cache.query = buildUpsertQueryPostgres(dialect, "\"coffeecloud\".\"configuration\"", updateOnConflict, ret, update, conflict, insert, opts...)
 
 		cache.valueMapping, err = queries.BindMapping(configurationType, configurationMapping, insert)
 		if err != nil {
@@ -1229,7 +2288,7 @@ func (o ConfigurationSlice) DeleteAll(ctx context.Context, exec boil.ContextExec
 	}
 
 	sql := "DELETE FROM \"coffeecloud\".\"configuration\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, configurationPrimaryKeyColumns, len(o))
+		strmangle.WhereInClause(string(dialect.LQ), string(dialect.RQ), 1, configurationPrimaryKeyColumns, len(o))
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -0,0 +1,1257 @@
+// Code generated by SQLBoiler 4.17.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MachineCleaning is an object representing the database table.
+type MachineCleaning struct {
+	ID              int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID int64     `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	SerialNumber    string    `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	CleanedAt       time.Time `boil:"cleaned_at" json:"cleaned_at" toml:"cleaned_at" yaml:"cleaned_at"`
+	HoursBefore     int32     `boil:"hours_before" json:"hours_before" toml:"hours_before" yaml:"hours_before"`
+
+	R *machineCleaningR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L machineCleaningL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MachineCleaningColumns = struct {
+	ID              string
+	ConfigurationID string
+	SerialNumber    string
+	CleanedAt       string
+	HoursBefore     string
+}{
+	ID:              "id",
+	ConfigurationID: "configuration_id",
+	SerialNumber:    "serial_number",
+	CleanedAt:       "cleaned_at",
+	HoursBefore:     "hours_before",
+}
+
+var MachineCleaningTableColumns = struct {
+	ID              string
+	ConfigurationID string
+	SerialNumber    string
+	CleanedAt       string
+	HoursBefore     string
+}{
+	ID:              "machine_cleaning.id",
+	ConfigurationID: "machine_cleaning.configuration_id",
+	SerialNumber:    "machine_cleaning.serial_number",
+	CleanedAt:       "machine_cleaning.cleaned_at",
+	HoursBefore:     "machine_cleaning.hours_before",
+}
+
+// Generated where
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var MachineCleaningWhere = struct {
+	ID              whereHelperint64
+	ConfigurationID whereHelperint64
+	SerialNumber    whereHelperstring
+	CleanedAt       whereHelpertime_Time
+	HoursBefore     whereHelperint32
+}{
+	ID:              whereHelperint64{field: "\"coffeecloud\".\"machine_cleaning\".\"id\""},
+	ConfigurationID: whereHelperint64{field: "\"coffeecloud\".\"machine_cleaning\".\"configuration_id\""},
+	SerialNumber:    whereHelperstring{field: "\"coffeecloud\".\"machine_cleaning\".\"serial_number\""},
+	CleanedAt:       whereHelpertime_Time{field: "\"coffeecloud\".\"machine_cleaning\".\"cleaned_at\""},
+	HoursBefore:     whereHelperint32{field: "\"coffeecloud\".\"machine_cleaning\".\"hours_before\""},
+}
+
+// MachineCleaningRels is where relationship names are stored.
+var MachineCleaningRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// machineCleaningR is where relationships are stored.
+type machineCleaningR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*machineCleaningR) NewStruct() *machineCleaningR {
+	return &machineCleaningR{}
+}
+
+func (r *machineCleaningR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// machineCleaningL is where Load methods for each relationship are stored.
+type machineCleaningL struct{}
+
+var (
+	machineCleaningAllColumns            = []string{"id", "configuration_id", "serial_number", "cleaned_at", "hours_before"}
+	machineCleaningColumnsWithoutDefault = []string{"configuration_id", "serial_number", "cleaned_at", "hours_before"}
+	machineCleaningColumnsWithDefault    = []string{"id"}
+	machineCleaningPrimaryKeyColumns     = []string{"id"}
+	machineCleaningGeneratedColumns      = []string{}
+)
+
+type (
+	// MachineCleaningSlice is an alias for a slice of pointers to MachineCleaning.
+	// This should almost always be used instead of []MachineCleaning.
+	MachineCleaningSlice []*MachineCleaning
+	// MachineCleaningHook is the signature for custom MachineCleaning hook methods
+	MachineCleaningHook func(context.Context, boil.ContextExecutor, *MachineCleaning) error
+
+	machineCleaningQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	machineCleaningType                 = reflect.TypeOf(&MachineCleaning{})
+	machineCleaningMapping              = queries.MakeStructMapping(machineCleaningType)
+	machineCleaningPrimaryKeyMapping, _ = queries.BindMapping(machineCleaningType, machineCleaningMapping, machineCleaningPrimaryKeyColumns)
+	machineCleaningInsertCacheMut       sync.RWMutex
+	machineCleaningInsertCache          = make(map[string]insertCache)
+	machineCleaningUpdateCacheMut       sync.RWMutex
+	machineCleaningUpdateCache          = make(map[string]updateCache)
+	machineCleaningUpsertCacheMut       sync.RWMutex
+	machineCleaningUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var machineCleaningAfterSelectMu sync.Mutex
+var machineCleaningAfterSelectHooks []MachineCleaningHook
+
+var machineCleaningBeforeInsertMu sync.Mutex
+var machineCleaningBeforeInsertHooks []MachineCleaningHook
+var machineCleaningAfterInsertMu sync.Mutex
+var machineCleaningAfterInsertHooks []MachineCleaningHook
+
+var machineCleaningBeforeUpdateMu sync.Mutex
+var machineCleaningBeforeUpdateHooks []MachineCleaningHook
+var machineCleaningAfterUpdateMu sync.Mutex
+var machineCleaningAfterUpdateHooks []MachineCleaningHook
+
+var machineCleaningBeforeDeleteMu sync.Mutex
+var machineCleaningBeforeDeleteHooks []MachineCleaningHook
+var machineCleaningAfterDeleteMu sync.Mutex
+var machineCleaningAfterDeleteHooks []MachineCleaningHook
+
+var machineCleaningBeforeUpsertMu sync.Mutex
+var machineCleaningBeforeUpsertHooks []MachineCleaningHook
+var machineCleaningAfterUpsertMu sync.Mutex
+var machineCleaningAfterUpsertHooks []MachineCleaningHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MachineCleaning) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineCleaningAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MachineCleaning) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineCleaningBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MachineCleaning) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineCleaningAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MachineCleaning) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineCleaningBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MachineCleaning) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineCleaningAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MachineCleaning) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineCleaningBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MachineCleaning) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineCleaningAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MachineCleaning) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineCleaningBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MachineCleaning) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineCleaningAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMachineCleaningHook registers your hook function for all future operations.
+func AddMachineCleaningHook(hookPoint boil.HookPoint, machineCleaningHook MachineCleaningHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		machineCleaningAfterSelectMu.Lock()
+		machineCleaningAfterSelectHooks = append(machineCleaningAfterSelectHooks, machineCleaningHook)
+		machineCleaningAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		machineCleaningBeforeInsertMu.Lock()
+		machineCleaningBeforeInsertHooks = append(machineCleaningBeforeInsertHooks, machineCleaningHook)
+		machineCleaningBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		machineCleaningAfterInsertMu.Lock()
+		machineCleaningAfterInsertHooks = append(machineCleaningAfterInsertHooks, machineCleaningHook)
+		machineCleaningAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		machineCleaningBeforeUpdateMu.Lock()
+		machineCleaningBeforeUpdateHooks = append(machineCleaningBeforeUpdateHooks, machineCleaningHook)
+		machineCleaningBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		machineCleaningAfterUpdateMu.Lock()
+		machineCleaningAfterUpdateHooks = append(machineCleaningAfterUpdateHooks, machineCleaningHook)
+		machineCleaningAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		machineCleaningBeforeDeleteMu.Lock()
+		machineCleaningBeforeDeleteHooks = append(machineCleaningBeforeDeleteHooks, machineCleaningHook)
+		machineCleaningBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		machineCleaningAfterDeleteMu.Lock()
+		machineCleaningAfterDeleteHooks = append(machineCleaningAfterDeleteHooks, machineCleaningHook)
+		machineCleaningAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		machineCleaningBeforeUpsertMu.Lock()
+		machineCleaningBeforeUpsertHooks = append(machineCleaningBeforeUpsertHooks, machineCleaningHook)
+		machineCleaningBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		machineCleaningAfterUpsertMu.Lock()
+		machineCleaningAfterUpsertHooks = append(machineCleaningAfterUpsertHooks, machineCleaningHook)
+		machineCleaningAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single machineCleaning record from the query using the global executor.
+func (q machineCleaningQuery) OneG(ctx context.Context) (*MachineCleaning, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single machineCleaning record from the query.
+func (q machineCleaningQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MachineCleaning, error) {
+	o := &MachineCleaning{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for machine_cleaning")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all MachineCleaning records from the query using the global executor.
+func (q machineCleaningQuery) AllG(ctx context.Context) (MachineCleaningSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all MachineCleaning records from the query.
+func (q machineCleaningQuery) All(ctx context.Context, exec boil.ContextExecutor) (MachineCleaningSlice, error) {
+	var o []*MachineCleaning
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to MachineCleaning slice")
+	}
+
+	if len(machineCleaningAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all MachineCleaning records in the query using the global executor
+func (q machineCleaningQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all MachineCleaning records in the query.
+func (q machineCleaningQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count machine_cleaning rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q machineCleaningQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q machineCleaningQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if machine_cleaning exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *MachineCleaning) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (machineCleaningL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMachineCleaning interface{}, mods queries.Applicator) error {
+	var slice []*MachineCleaning
+	var object *MachineCleaning
+
+	if singular {
+		var ok bool
+		object, ok = maybeMachineCleaning.(*MachineCleaning)
+		if !ok {
+			object = new(MachineCleaning)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMachineCleaning)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMachineCleaning))
+			}
+		}
+	} else {
+		s, ok := maybeMachineCleaning.(*[]*MachineCleaning)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMachineCleaning)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMachineCleaning))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &machineCleaningR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &machineCleaningR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`coffeecloud.configuration`),
+		qm.WhereIn(`coffeecloud.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.MachineCleanings = append(foreign.R.MachineCleanings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.MachineCleanings = append(foreign.R.MachineCleanings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the machineCleaning to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.MachineCleanings.
+// Uses the global database handle.
+func (o *MachineCleaning) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the machineCleaning to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.MachineCleanings.
+func (o *MachineCleaning) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"coffeecloud\".\"machine_cleaning\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, machineCleaningPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &machineCleaningR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			MachineCleanings: MachineCleaningSlice{o},
+		}
+	} else {
+		related.R.MachineCleanings = append(related.R.MachineCleanings, o)
+	}
+
+	return nil
+}
+
+// MachineCleanings retrieves all the records using an executor.
+func MachineCleanings(mods ...qm.QueryMod) machineCleaningQuery {
+	mods = append(mods, qm.From("\"coffeecloud\".\"machine_cleaning\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"coffeecloud\".\"machine_cleaning\".*"})
+	}
+
+	return machineCleaningQuery{q}
+}
+
+// FindMachineCleaningG retrieves a single record by ID.
+func FindMachineCleaningG(ctx context.Context, iD int64, selectCols ...string) (*MachineCleaning, error) {
+	return FindMachineCleaning(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindMachineCleaning retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMachineCleaning(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*MachineCleaning, error) {
+	machineCleaningObj := &MachineCleaning{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"coffeecloud\".\"machine_cleaning\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, machineCleaningObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from machine_cleaning")
+	}
+
+	if err = machineCleaningObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return machineCleaningObj, err
+	}
+
+	return machineCleaningObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *MachineCleaning) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MachineCleaning) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no machine_cleaning provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(machineCleaningColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	machineCleaningInsertCacheMut.RLock()
+	cache, cached := machineCleaningInsertCache[key]
+	machineCleaningInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			machineCleaningAllColumns,
+			machineCleaningColumnsWithDefault,
+			machineCleaningColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(machineCleaningType, machineCleaningMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(machineCleaningType, machineCleaningMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"coffeecloud\".\"machine_cleaning\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"coffeecloud\".\"machine_cleaning\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into machine_cleaning")
+	}
+
+	if !cached {
+		machineCleaningInsertCacheMut.Lock()
+		machineCleaningInsertCache[key] = cache
+		machineCleaningInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single MachineCleaning record using the global executor.
+// See Update for more documentation.
+func (o *MachineCleaning) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the MachineCleaning.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MachineCleaning) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	machineCleaningUpdateCacheMut.RLock()
+	cache, cached := machineCleaningUpdateCache[key]
+	machineCleaningUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			machineCleaningAllColumns,
+			machineCleaningPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update machine_cleaning, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"coffeecloud\".\"machine_cleaning\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, machineCleaningPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(machineCleaningType, machineCleaningMapping, append(wl, machineCleaningPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update machine_cleaning row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for machine_cleaning")
+	}
+
+	if !cached {
+		machineCleaningUpdateCacheMut.Lock()
+		machineCleaningUpdateCache[key] = cache
+		machineCleaningUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q machineCleaningQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q machineCleaningQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for machine_cleaning")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for machine_cleaning")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o MachineCleaningSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MachineCleaningSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineCleaningPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"coffeecloud\".\"machine_cleaning\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, machineCleaningPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in machineCleaning slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all machineCleaning")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *MachineCleaning) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MachineCleaning) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no machine_cleaning provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(machineCleaningColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	machineCleaningUpsertCacheMut.RLock()
+	cache, cached := machineCleaningUpsertCache[key]
+	machineCleaningUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			machineCleaningAllColumns,
+			machineCleaningColumnsWithDefault,
+			machineCleaningColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			machineCleaningAllColumns,
+			machineCleaningPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert machine_cleaning, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(machineCleaningAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(machineCleaningPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert machine_cleaning, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(machineCleaningPrimaryKeyColumns))
+			copy(conflict, machineCleaningPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"coffeecloud\".\"machine_cleaning\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(machineCleaningType, machineCleaningMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(machineCleaningType, machineCleaningMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert machine_cleaning")
+	}
+
+	if !cached {
+		machineCleaningUpsertCacheMut.Lock()
+		machineCleaningUpsertCache[key] = cache
+		machineCleaningUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single MachineCleaning record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *MachineCleaning) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single MachineCleaning record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MachineCleaning) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no MachineCleaning provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), machineCleaningPrimaryKeyMapping)
+	sql := "DELETE FROM \"coffeecloud\".\"machine_cleaning\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from machine_cleaning")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for machine_cleaning")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q machineCleaningQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q machineCleaningQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no machineCleaningQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from machine_cleaning")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for machine_cleaning")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o MachineCleaningSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MachineCleaningSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(machineCleaningBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineCleaningPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"coffeecloud\".\"machine_cleaning\" WHERE " +
+		strmangle.WhereInClause(string(dialect.LQ), string(dialect.RQ), 1, machineCleaningPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from machineCleaning slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for machine_cleaning")
+	}
+
+	if len(machineCleaningAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *MachineCleaning) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no MachineCleaning provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MachineCleaning) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMachineCleaning(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MachineCleaningSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty MachineCleaningSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MachineCleaningSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MachineCleaningSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineCleaningPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"coffeecloud\".\"machine_cleaning\".* FROM \"coffeecloud\".\"machine_cleaning\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, machineCleaningPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in MachineCleaningSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MachineCleaningExistsG checks if the MachineCleaning row exists.
+func MachineCleaningExistsG(ctx context.Context, iD int64) (bool, error) {
+	return MachineCleaningExists(ctx, boil.GetContextDB(), iD)
+}
+
+// MachineCleaningExists checks if the MachineCleaning row exists.
+func MachineCleaningExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"coffeecloud\".\"machine_cleaning\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if machine_cleaning exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MachineCleaning row exists.
+func (o *MachineCleaning) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MachineCleaningExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1264 @@
+// Code generated by SQLBoiler 4.17.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MachineError is an object representing the database table.
+type MachineError struct {
+	ID              int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID int64     `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	ProjectID       string    `boil:"project_id" json:"project_id" toml:"project_id" yaml:"project_id"`
+	GroupID         string    `boil:"group_id" json:"group_id" toml:"group_id" yaml:"group_id"`
+	SerialNumber    string    `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	ErrorCode       int32     `boil:"error_code" json:"error_code" toml:"error_code" yaml:"error_code"`
+	Error           string    `boil:"error" json:"error" toml:"error" yaml:"error"`
+	ErrorShort      string    `boil:"error_short" json:"error_short" toml:"error_short" yaml:"error_short"`
+	OccurredAt      time.Time `boil:"occurred_at" json:"occurred_at" toml:"occurred_at" yaml:"occurred_at"`
+
+	R *machineErrorR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L machineErrorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MachineErrorColumns = struct {
+	ID              string
+	ConfigurationID string
+	ProjectID       string
+	GroupID         string
+	SerialNumber    string
+	ErrorCode       string
+	Error           string
+	ErrorShort      string
+	OccurredAt      string
+}{
+	ID:              "id",
+	ConfigurationID: "configuration_id",
+	ProjectID:       "project_id",
+	GroupID:         "group_id",
+	SerialNumber:    "serial_number",
+	ErrorCode:       "error_code",
+	Error:           "error",
+	ErrorShort:      "error_short",
+	OccurredAt:      "occurred_at",
+}
+
+var MachineErrorTableColumns = struct {
+	ID              string
+	ConfigurationID string
+	ProjectID       string
+	GroupID         string
+	SerialNumber    string
+	ErrorCode       string
+	Error           string
+	ErrorShort      string
+	OccurredAt      string
+}{
+	ID:              "machine_error.id",
+	ConfigurationID: "machine_error.configuration_id",
+	ProjectID:       "machine_error.project_id",
+	GroupID:         "machine_error.group_id",
+	SerialNumber:    "machine_error.serial_number",
+	ErrorCode:       "machine_error.error_code",
+	Error:           "machine_error.error",
+	ErrorShort:      "machine_error.error_short",
+	OccurredAt:      "machine_error.occurred_at",
+}
+
+// Generated where
+
+var MachineErrorWhere = struct {
+	ID              whereHelperint64
+	ConfigurationID whereHelperint64
+	ProjectID       whereHelperstring
+	GroupID         whereHelperstring
+	SerialNumber    whereHelperstring
+	ErrorCode       whereHelperint32
+	Error           whereHelperstring
+	ErrorShort      whereHelperstring
+	OccurredAt      whereHelpertime_Time
+}{
+	ID:              whereHelperint64{field: "\"coffeecloud\".\"machine_error\".\"id\""},
+	ConfigurationID: whereHelperint64{field: "\"coffeecloud\".\"machine_error\".\"configuration_id\""},
+	ProjectID:       whereHelperstring{field: "\"coffeecloud\".\"machine_error\".\"project_id\""},
+	GroupID:         whereHelperstring{field: "\"coffeecloud\".\"machine_error\".\"group_id\""},
+	SerialNumber:    whereHelperstring{field: "\"coffeecloud\".\"machine_error\".\"serial_number\""},
+	ErrorCode:       whereHelperint32{field: "\"coffeecloud\".\"machine_error\".\"error_code\""},
+	Error:           whereHelperstring{field: "\"coffeecloud\".\"machine_error\".\"error\""},
+	ErrorShort:      whereHelperstring{field: "\"coffeecloud\".\"machine_error\".\"error_short\""},
+	OccurredAt:      whereHelpertime_Time{field: "\"coffeecloud\".\"machine_error\".\"occurred_at\""},
+}
+
+// MachineErrorRels is where relationship names are stored.
+var MachineErrorRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// machineErrorR is where relationships are stored.
+type machineErrorR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*machineErrorR) NewStruct() *machineErrorR {
+	return &machineErrorR{}
+}
+
+func (r *machineErrorR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// machineErrorL is where Load methods for each relationship are stored.
+type machineErrorL struct{}
+
+var (
+	machineErrorAllColumns            = []string{"id", "configuration_id", "project_id", "group_id", "serial_number", "error_code", "error", "error_short", "occurred_at"}
+	machineErrorColumnsWithoutDefault = []string{"configuration_id", "project_id", "group_id", "serial_number", "error_code", "error", "error_short", "occurred_at"}
+	machineErrorColumnsWithDefault    = []string{"id"}
+	machineErrorPrimaryKeyColumns     = []string{"id"}
+	machineErrorGeneratedColumns      = []string{}
+)
+
+type (
+	// MachineErrorSlice is an alias for a slice of pointers to MachineError.
+	// This should almost always be used instead of []MachineError.
+	MachineErrorSlice []*MachineError
+	// MachineErrorHook is the signature for custom MachineError hook methods
+	MachineErrorHook func(context.Context, boil.ContextExecutor, *MachineError) error
+
+	machineErrorQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	machineErrorType                 = reflect.TypeOf(&MachineError{})
+	machineErrorMapping              = queries.MakeStructMapping(machineErrorType)
+	machineErrorPrimaryKeyMapping, _ = queries.BindMapping(machineErrorType, machineErrorMapping, machineErrorPrimaryKeyColumns)
+	machineErrorInsertCacheMut       sync.RWMutex
+	machineErrorInsertCache          = make(map[string]insertCache)
+	machineErrorUpdateCacheMut       sync.RWMutex
+	machineErrorUpdateCache          = make(map[string]updateCache)
+	machineErrorUpsertCacheMut       sync.RWMutex
+	machineErrorUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var machineErrorAfterSelectMu sync.Mutex
+var machineErrorAfterSelectHooks []MachineErrorHook
+
+var machineErrorBeforeInsertMu sync.Mutex
+var machineErrorBeforeInsertHooks []MachineErrorHook
+var machineErrorAfterInsertMu sync.Mutex
+var machineErrorAfterInsertHooks []MachineErrorHook
+
+var machineErrorBeforeUpdateMu sync.Mutex
+var machineErrorBeforeUpdateHooks []MachineErrorHook
+var machineErrorAfterUpdateMu sync.Mutex
+var machineErrorAfterUpdateHooks []MachineErrorHook
+
+var machineErrorBeforeDeleteMu sync.Mutex
+var machineErrorBeforeDeleteHooks []MachineErrorHook
+var machineErrorAfterDeleteMu sync.Mutex
+var machineErrorAfterDeleteHooks []MachineErrorHook
+
+var machineErrorBeforeUpsertMu sync.Mutex
+var machineErrorBeforeUpsertHooks []MachineErrorHook
+var machineErrorAfterUpsertMu sync.Mutex
+var machineErrorAfterUpsertHooks []MachineErrorHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MachineError) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MachineError) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MachineError) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MachineError) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MachineError) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MachineError) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MachineError) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MachineError) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MachineError) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMachineErrorHook registers your hook function for all future operations.
+func AddMachineErrorHook(hookPoint boil.HookPoint, machineErrorHook MachineErrorHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		machineErrorAfterSelectMu.Lock()
+		machineErrorAfterSelectHooks = append(machineErrorAfterSelectHooks, machineErrorHook)
+		machineErrorAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		machineErrorBeforeInsertMu.Lock()
+		machineErrorBeforeInsertHooks = append(machineErrorBeforeInsertHooks, machineErrorHook)
+		machineErrorBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		machineErrorAfterInsertMu.Lock()
+		machineErrorAfterInsertHooks = append(machineErrorAfterInsertHooks, machineErrorHook)
+		machineErrorAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		machineErrorBeforeUpdateMu.Lock()
+		machineErrorBeforeUpdateHooks = append(machineErrorBeforeUpdateHooks, machineErrorHook)
+		machineErrorBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		machineErrorAfterUpdateMu.Lock()
+		machineErrorAfterUpdateHooks = append(machineErrorAfterUpdateHooks, machineErrorHook)
+		machineErrorAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		machineErrorBeforeDeleteMu.Lock()
+		machineErrorBeforeDeleteHooks = append(machineErrorBeforeDeleteHooks, machineErrorHook)
+		machineErrorBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		machineErrorAfterDeleteMu.Lock()
+		machineErrorAfterDeleteHooks = append(machineErrorAfterDeleteHooks, machineErrorHook)
+		machineErrorAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		machineErrorBeforeUpsertMu.Lock()
+		machineErrorBeforeUpsertHooks = append(machineErrorBeforeUpsertHooks, machineErrorHook)
+		machineErrorBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		machineErrorAfterUpsertMu.Lock()
+		machineErrorAfterUpsertHooks = append(machineErrorAfterUpsertHooks, machineErrorHook)
+		machineErrorAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single machineError record from the query using the global executor.
+func (q machineErrorQuery) OneG(ctx context.Context) (*MachineError, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single machineError record from the query.
+func (q machineErrorQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MachineError, error) {
+	o := &MachineError{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for machine_error")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all MachineError records from the query using the global executor.
+func (q machineErrorQuery) AllG(ctx context.Context) (MachineErrorSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all MachineError records from the query.
+func (q machineErrorQuery) All(ctx context.Context, exec boil.ContextExecutor) (MachineErrorSlice, error) {
+	var o []*MachineError
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to MachineError slice")
+	}
+
+	if len(machineErrorAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all MachineError records in the query using the global executor
+func (q machineErrorQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all MachineError records in the query.
+func (q machineErrorQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count machine_error rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q machineErrorQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q machineErrorQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if machine_error exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *MachineError) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (machineErrorL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMachineError interface{}, mods queries.Applicator) error {
+	var slice []*MachineError
+	var object *MachineError
+
+	if singular {
+		var ok bool
+		object, ok = maybeMachineError.(*MachineError)
+		if !ok {
+			object = new(MachineError)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMachineError)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMachineError))
+			}
+		}
+	} else {
+		s, ok := maybeMachineError.(*[]*MachineError)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMachineError)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMachineError))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &machineErrorR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &machineErrorR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`coffeecloud.configuration`),
+		qm.WhereIn(`coffeecloud.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.MachineErrors = append(foreign.R.MachineErrors, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.MachineErrors = append(foreign.R.MachineErrors, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the machineError to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.MachineErrors.
+// Uses the global database handle.
+func (o *MachineError) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the machineError to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.MachineErrors.
+func (o *MachineError) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"coffeecloud\".\"machine_error\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, machineErrorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &machineErrorR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			MachineErrors: MachineErrorSlice{o},
+		}
+	} else {
+		related.R.MachineErrors = append(related.R.MachineErrors, o)
+	}
+
+	return nil
+}
+
+// MachineErrors retrieves all the records using an executor.
+func MachineErrors(mods ...qm.QueryMod) machineErrorQuery {
+	mods = append(mods, qm.From("\"coffeecloud\".\"machine_error\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"coffeecloud\".\"machine_error\".*"})
+	}
+
+	return machineErrorQuery{q}
+}
+
+// FindMachineErrorG retrieves a single record by ID.
+func FindMachineErrorG(ctx context.Context, iD int64, selectCols ...string) (*MachineError, error) {
+	return FindMachineError(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindMachineError retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMachineError(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*MachineError, error) {
+	machineErrorObj := &MachineError{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"coffeecloud\".\"machine_error\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, machineErrorObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from machine_error")
+	}
+
+	if err = machineErrorObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return machineErrorObj, err
+	}
+
+	return machineErrorObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *MachineError) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MachineError) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no machine_error provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(machineErrorColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	machineErrorInsertCacheMut.RLock()
+	cache, cached := machineErrorInsertCache[key]
+	machineErrorInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			machineErrorAllColumns,
+			machineErrorColumnsWithDefault,
+			machineErrorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(machineErrorType, machineErrorMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(machineErrorType, machineErrorMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"coffeecloud\".\"machine_error\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"coffeecloud\".\"machine_error\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into machine_error")
+	}
+
+	if !cached {
+		machineErrorInsertCacheMut.Lock()
+		machineErrorInsertCache[key] = cache
+		machineErrorInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single MachineError record using the global executor.
+// See Update for more documentation.
+func (o *MachineError) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the MachineError.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MachineError) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	machineErrorUpdateCacheMut.RLock()
+	cache, cached := machineErrorUpdateCache[key]
+	machineErrorUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			machineErrorAllColumns,
+			machineErrorPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update machine_error, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"coffeecloud\".\"machine_error\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, machineErrorPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(machineErrorType, machineErrorMapping, append(wl, machineErrorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update machine_error row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for machine_error")
+	}
+
+	if !cached {
+		machineErrorUpdateCacheMut.Lock()
+		machineErrorUpdateCache[key] = cache
+		machineErrorUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q machineErrorQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q machineErrorQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for machine_error")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for machine_error")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o MachineErrorSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MachineErrorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineErrorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"coffeecloud\".\"machine_error\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, machineErrorPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in machineError slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all machineError")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *MachineError) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MachineError) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no machine_error provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(machineErrorColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	machineErrorUpsertCacheMut.RLock()
+	cache, cached := machineErrorUpsertCache[key]
+	machineErrorUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			machineErrorAllColumns,
+			machineErrorColumnsWithDefault,
+			machineErrorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			machineErrorAllColumns,
+			machineErrorPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert machine_error, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(machineErrorAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(machineErrorPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert machine_error, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(machineErrorPrimaryKeyColumns))
+			copy(conflict, machineErrorPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"coffeecloud\".\"machine_error\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(machineErrorType, machineErrorMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(machineErrorType, machineErrorMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert machine_error")
+	}
+
+	if !cached {
+		machineErrorUpsertCacheMut.Lock()
+		machineErrorUpsertCache[key] = cache
+		machineErrorUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single MachineError record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *MachineError) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single MachineError record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MachineError) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no MachineError provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), machineErrorPrimaryKeyMapping)
+	sql := "DELETE FROM \"coffeecloud\".\"machine_error\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from machine_error")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for machine_error")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q machineErrorQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q machineErrorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no machineErrorQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from machine_error")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for machine_error")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o MachineErrorSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MachineErrorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(machineErrorBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineErrorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"coffeecloud\".\"machine_error\" WHERE " +
+		strmangle.WhereInClause(string(dialect.LQ), string(dialect.RQ), 1, machineErrorPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from machineError slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for machine_error")
+	}
+
+	if len(machineErrorAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *MachineError) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no MachineError provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MachineError) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMachineError(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MachineErrorSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty MachineErrorSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MachineErrorSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MachineErrorSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineErrorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"coffeecloud\".\"machine_error\".* FROM \"coffeecloud\".\"machine_error\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, machineErrorPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in MachineErrorSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MachineErrorExistsG checks if the MachineError row exists.
+func MachineErrorExistsG(ctx context.Context, iD int64) (bool, error) {
+	return MachineErrorExists(ctx, boil.GetContextDB(), iD)
+}
+
+// MachineErrorExists checks if the MachineError row exists.
+func MachineErrorExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"coffeecloud\".\"machine_error\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if machine_error exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MachineError row exists.
+func (o *MachineError) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MachineErrorExists(ctx, exec, o.ID)
+}
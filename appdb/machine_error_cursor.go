@@ -0,0 +1,1231 @@
+// Code generated by SQLBoiler 4.17.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MachineErrorCursor is an object representing the database table.
+type MachineErrorCursor struct {
+	ConfigurationID int64     `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	FetchedUntil    time.Time `boil:"fetched_until" json:"fetched_until" toml:"fetched_until" yaml:"fetched_until"`
+	Refetch         bool      `boil:"refetch" json:"refetch" toml:"refetch" yaml:"refetch"`
+
+	R *machineErrorCursorR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L machineErrorCursorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MachineErrorCursorColumns = struct {
+	ConfigurationID string
+	FetchedUntil    string
+	Refetch         string
+}{
+	ConfigurationID: "configuration_id",
+	FetchedUntil:    "fetched_until",
+	Refetch:         "refetch",
+}
+
+var MachineErrorCursorTableColumns = struct {
+	ConfigurationID string
+	FetchedUntil    string
+	Refetch         string
+}{
+	ConfigurationID: "machine_error_cursor.configuration_id",
+	FetchedUntil:    "machine_error_cursor.fetched_until",
+	Refetch:         "machine_error_cursor.refetch",
+}
+
+// Generated where
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var MachineErrorCursorWhere = struct {
+	ConfigurationID whereHelperint64
+	FetchedUntil    whereHelpertime_Time
+	Refetch         whereHelperbool
+}{
+	ConfigurationID: whereHelperint64{field: "\"coffeecloud\".\"machine_error_cursor\".\"configuration_id\""},
+	FetchedUntil:    whereHelpertime_Time{field: "\"coffeecloud\".\"machine_error_cursor\".\"fetched_until\""},
+	Refetch:         whereHelperbool{field: "\"coffeecloud\".\"machine_error_cursor\".\"refetch\""},
+}
+
+// MachineErrorCursorRels is where relationship names are stored.
+var MachineErrorCursorRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// machineErrorCursorR is where relationships are stored.
+type machineErrorCursorR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*machineErrorCursorR) NewStruct() *machineErrorCursorR {
+	return &machineErrorCursorR{}
+}
+
+func (r *machineErrorCursorR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// machineErrorCursorL is where Load methods for each relationship are stored.
+type machineErrorCursorL struct{}
+
+var (
+	machineErrorCursorAllColumns            = []string{"configuration_id", "fetched_until", "refetch"}
+	machineErrorCursorColumnsWithoutDefault = []string{"configuration_id", "fetched_until"}
+	machineErrorCursorColumnsWithDefault    = []string{"refetch"}
+	machineErrorCursorPrimaryKeyColumns     = []string{"configuration_id"}
+	machineErrorCursorGeneratedColumns      = []string{}
+)
+
+type (
+	// MachineErrorCursorSlice is an alias for a slice of pointers to MachineErrorCursor.
+	// This should almost always be used instead of []MachineErrorCursor.
+	MachineErrorCursorSlice []*MachineErrorCursor
+	// MachineErrorCursorHook is the signature for custom MachineErrorCursor hook methods
+	MachineErrorCursorHook func(context.Context, boil.ContextExecutor, *MachineErrorCursor) error
+
+	machineErrorCursorQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	machineErrorCursorType                 = reflect.TypeOf(&MachineErrorCursor{})
+	machineErrorCursorMapping              = queries.MakeStructMapping(machineErrorCursorType)
+	machineErrorCursorPrimaryKeyMapping, _ = queries.BindMapping(machineErrorCursorType, machineErrorCursorMapping, machineErrorCursorPrimaryKeyColumns)
+	machineErrorCursorInsertCacheMut       sync.RWMutex
+	machineErrorCursorInsertCache          = make(map[string]insertCache)
+	machineErrorCursorUpdateCacheMut       sync.RWMutex
+	machineErrorCursorUpdateCache          = make(map[string]updateCache)
+	machineErrorCursorUpsertCacheMut       sync.RWMutex
+	machineErrorCursorUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var machineErrorCursorAfterSelectMu sync.Mutex
+var machineErrorCursorAfterSelectHooks []MachineErrorCursorHook
+
+var machineErrorCursorBeforeInsertMu sync.Mutex
+var machineErrorCursorBeforeInsertHooks []MachineErrorCursorHook
+var machineErrorCursorAfterInsertMu sync.Mutex
+var machineErrorCursorAfterInsertHooks []MachineErrorCursorHook
+
+var machineErrorCursorBeforeUpdateMu sync.Mutex
+var machineErrorCursorBeforeUpdateHooks []MachineErrorCursorHook
+var machineErrorCursorAfterUpdateMu sync.Mutex
+var machineErrorCursorAfterUpdateHooks []MachineErrorCursorHook
+
+var machineErrorCursorBeforeDeleteMu sync.Mutex
+var machineErrorCursorBeforeDeleteHooks []MachineErrorCursorHook
+var machineErrorCursorAfterDeleteMu sync.Mutex
+var machineErrorCursorAfterDeleteHooks []MachineErrorCursorHook
+
+var machineErrorCursorBeforeUpsertMu sync.Mutex
+var machineErrorCursorBeforeUpsertHooks []MachineErrorCursorHook
+var machineErrorCursorAfterUpsertMu sync.Mutex
+var machineErrorCursorAfterUpsertHooks []MachineErrorCursorHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MachineErrorCursor) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorCursorAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MachineErrorCursor) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorCursorBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MachineErrorCursor) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorCursorAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MachineErrorCursor) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorCursorBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MachineErrorCursor) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorCursorAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MachineErrorCursor) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorCursorBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MachineErrorCursor) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorCursorAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MachineErrorCursor) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorCursorBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MachineErrorCursor) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineErrorCursorAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMachineErrorCursorHook registers your hook function for all future operations.
+func AddMachineErrorCursorHook(hookPoint boil.HookPoint, machineErrorCursorHook MachineErrorCursorHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		machineErrorCursorAfterSelectMu.Lock()
+		machineErrorCursorAfterSelectHooks = append(machineErrorCursorAfterSelectHooks, machineErrorCursorHook)
+		machineErrorCursorAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		machineErrorCursorBeforeInsertMu.Lock()
+		machineErrorCursorBeforeInsertHooks = append(machineErrorCursorBeforeInsertHooks, machineErrorCursorHook)
+		machineErrorCursorBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		machineErrorCursorAfterInsertMu.Lock()
+		machineErrorCursorAfterInsertHooks = append(machineErrorCursorAfterInsertHooks, machineErrorCursorHook)
+		machineErrorCursorAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		machineErrorCursorBeforeUpdateMu.Lock()
+		machineErrorCursorBeforeUpdateHooks = append(machineErrorCursorBeforeUpdateHooks, machineErrorCursorHook)
+		machineErrorCursorBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		machineErrorCursorAfterUpdateMu.Lock()
+		machineErrorCursorAfterUpdateHooks = append(machineErrorCursorAfterUpdateHooks, machineErrorCursorHook)
+		machineErrorCursorAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		machineErrorCursorBeforeDeleteMu.Lock()
+		machineErrorCursorBeforeDeleteHooks = append(machineErrorCursorBeforeDeleteHooks, machineErrorCursorHook)
+		machineErrorCursorBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		machineErrorCursorAfterDeleteMu.Lock()
+		machineErrorCursorAfterDeleteHooks = append(machineErrorCursorAfterDeleteHooks, machineErrorCursorHook)
+		machineErrorCursorAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		machineErrorCursorBeforeUpsertMu.Lock()
+		machineErrorCursorBeforeUpsertHooks = append(machineErrorCursorBeforeUpsertHooks, machineErrorCursorHook)
+		machineErrorCursorBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		machineErrorCursorAfterUpsertMu.Lock()
+		machineErrorCursorAfterUpsertHooks = append(machineErrorCursorAfterUpsertHooks, machineErrorCursorHook)
+		machineErrorCursorAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single machineErrorCursor record from the query using the global executor.
+func (q machineErrorCursorQuery) OneG(ctx context.Context) (*MachineErrorCursor, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single machineErrorCursor record from the query.
+func (q machineErrorCursorQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MachineErrorCursor, error) {
+	o := &MachineErrorCursor{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for machine_error_cursor")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all MachineErrorCursor records from the query using the global executor.
+func (q machineErrorCursorQuery) AllG(ctx context.Context) (MachineErrorCursorSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all MachineErrorCursor records from the query.
+func (q machineErrorCursorQuery) All(ctx context.Context, exec boil.ContextExecutor) (MachineErrorCursorSlice, error) {
+	var o []*MachineErrorCursor
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to MachineErrorCursor slice")
+	}
+
+	if len(machineErrorCursorAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all MachineErrorCursor records in the query using the global executor
+func (q machineErrorCursorQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all MachineErrorCursor records in the query.
+func (q machineErrorCursorQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count machine_error_cursor rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q machineErrorCursorQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q machineErrorCursorQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if machine_error_cursor exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *MachineErrorCursor) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (machineErrorCursorL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMachineErrorCursor interface{}, mods queries.Applicator) error {
+	var slice []*MachineErrorCursor
+	var object *MachineErrorCursor
+
+	if singular {
+		var ok bool
+		object, ok = maybeMachineErrorCursor.(*MachineErrorCursor)
+		if !ok {
+			object = new(MachineErrorCursor)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMachineErrorCursor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMachineErrorCursor))
+			}
+		}
+	} else {
+		s, ok := maybeMachineErrorCursor.(*[]*MachineErrorCursor)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMachineErrorCursor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMachineErrorCursor))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &machineErrorCursorR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &machineErrorCursorR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`coffeecloud.configuration`),
+		qm.WhereIn(`coffeecloud.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.MachineErrorCursor = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.MachineErrorCursor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the machineErrorCursor to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.MachineErrorCursor.
+// Uses the global database handle.
+func (o *MachineErrorCursor) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the machineErrorCursor to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.MachineErrorCursor.
+func (o *MachineErrorCursor) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"coffeecloud\".\"machine_error_cursor\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, machineErrorCursorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ConfigurationID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &machineErrorCursorR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			MachineErrorCursor: o,
+		}
+	} else {
+		related.R.MachineErrorCursor = o
+	}
+
+	return nil
+}
+
+// MachineErrorCursors retrieves all the records using an executor.
+func MachineErrorCursors(mods ...qm.QueryMod) machineErrorCursorQuery {
+	mods = append(mods, qm.From("\"coffeecloud\".\"machine_error_cursor\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"coffeecloud\".\"machine_error_cursor\".*"})
+	}
+
+	return machineErrorCursorQuery{q}
+}
+
+// FindMachineErrorCursorG retrieves a single record by ID.
+func FindMachineErrorCursorG(ctx context.Context, configurationID int64, selectCols ...string) (*MachineErrorCursor, error) {
+	return FindMachineErrorCursor(ctx, boil.GetContextDB(), configurationID, selectCols...)
+}
+
+// FindMachineErrorCursor retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMachineErrorCursor(ctx context.Context, exec boil.ContextExecutor, configurationID int64, selectCols ...string) (*MachineErrorCursor, error) {
+	machineErrorCursorObj := &MachineErrorCursor{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"coffeecloud\".\"machine_error_cursor\" where \"configuration_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, configurationID)
+
+	err := q.Bind(ctx, exec, machineErrorCursorObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from machine_error_cursor")
+	}
+
+	if err = machineErrorCursorObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return machineErrorCursorObj, err
+	}
+
+	return machineErrorCursorObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *MachineErrorCursor) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MachineErrorCursor) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no machine_error_cursor provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(machineErrorCursorColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	machineErrorCursorInsertCacheMut.RLock()
+	cache, cached := machineErrorCursorInsertCache[key]
+	machineErrorCursorInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			machineErrorCursorAllColumns,
+			machineErrorCursorColumnsWithDefault,
+			machineErrorCursorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(machineErrorCursorType, machineErrorCursorMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(machineErrorCursorType, machineErrorCursorMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"coffeecloud\".\"machine_error_cursor\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"coffeecloud\".\"machine_error_cursor\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into machine_error_cursor")
+	}
+
+	if !cached {
+		machineErrorCursorInsertCacheMut.Lock()
+		machineErrorCursorInsertCache[key] = cache
+		machineErrorCursorInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single MachineErrorCursor record using the global executor.
+// See Update for more documentation.
+func (o *MachineErrorCursor) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the MachineErrorCursor.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MachineErrorCursor) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	machineErrorCursorUpdateCacheMut.RLock()
+	cache, cached := machineErrorCursorUpdateCache[key]
+	machineErrorCursorUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			machineErrorCursorAllColumns,
+			machineErrorCursorPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update machine_error_cursor, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"coffeecloud\".\"machine_error_cursor\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, machineErrorCursorPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(machineErrorCursorType, machineErrorCursorMapping, append(wl, machineErrorCursorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update machine_error_cursor row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for machine_error_cursor")
+	}
+
+	if !cached {
+		machineErrorCursorUpdateCacheMut.Lock()
+		machineErrorCursorUpdateCache[key] = cache
+		machineErrorCursorUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q machineErrorCursorQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q machineErrorCursorQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for machine_error_cursor")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for machine_error_cursor")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o MachineErrorCursorSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MachineErrorCursorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineErrorCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"coffeecloud\".\"machine_error_cursor\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, machineErrorCursorPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in machineErrorCursor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all machineErrorCursor")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *MachineErrorCursor) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MachineErrorCursor) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no machine_error_cursor provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(machineErrorCursorColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	machineErrorCursorUpsertCacheMut.RLock()
+	cache, cached := machineErrorCursorUpsertCache[key]
+	machineErrorCursorUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			machineErrorCursorAllColumns,
+			machineErrorCursorColumnsWithDefault,
+			machineErrorCursorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			machineErrorCursorAllColumns,
+			machineErrorCursorPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert machine_error_cursor, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(machineErrorCursorAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(machineErrorCursorPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert machine_error_cursor, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(machineErrorCursorPrimaryKeyColumns))
+			copy(conflict, machineErrorCursorPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"coffeecloud\".\"machine_error_cursor\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(machineErrorCursorType, machineErrorCursorMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(machineErrorCursorType, machineErrorCursorMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert machine_error_cursor")
+	}
+
+	if !cached {
+		machineErrorCursorUpsertCacheMut.Lock()
+		machineErrorCursorUpsertCache[key] = cache
+		machineErrorCursorUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single MachineErrorCursor record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *MachineErrorCursor) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single MachineErrorCursor record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MachineErrorCursor) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no MachineErrorCursor provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), machineErrorCursorPrimaryKeyMapping)
+	sql := "DELETE FROM \"coffeecloud\".\"machine_error_cursor\" WHERE \"configuration_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from machine_error_cursor")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for machine_error_cursor")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q machineErrorCursorQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q machineErrorCursorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no machineErrorCursorQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from machine_error_cursor")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for machine_error_cursor")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o MachineErrorCursorSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MachineErrorCursorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(machineErrorCursorBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineErrorCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"coffeecloud\".\"machine_error_cursor\" WHERE " +
+		strmangle.WhereInClause(string(dialect.LQ), string(dialect.RQ), 1, machineErrorCursorPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from machineErrorCursor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for machine_error_cursor")
+	}
+
+	if len(machineErrorCursorAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *MachineErrorCursor) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no MachineErrorCursor provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MachineErrorCursor) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMachineErrorCursor(ctx, exec, o.ConfigurationID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MachineErrorCursorSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty MachineErrorCursorSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MachineErrorCursorSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MachineErrorCursorSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineErrorCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"coffeecloud\".\"machine_error_cursor\".* FROM \"coffeecloud\".\"machine_error_cursor\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, machineErrorCursorPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in MachineErrorCursorSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MachineErrorCursorExistsG checks if the MachineErrorCursor row exists.
+func MachineErrorCursorExistsG(ctx context.Context, configurationID int64) (bool, error) {
+	return MachineErrorCursorExists(ctx, boil.GetContextDB(), configurationID)
+}
+
+// MachineErrorCursorExists checks if the MachineErrorCursor row exists.
+func MachineErrorCursorExists(ctx context.Context, exec boil.ContextExecutor, configurationID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"coffeecloud\".\"machine_error_cursor\" where \"configuration_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, configurationID)
+	}
+	row := exec.QueryRowContext(ctx, sql, configurationID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if machine_error_cursor exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MachineErrorCursor row exists.
+func (o *MachineErrorCursor) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MachineErrorCursorExists(ctx, exec, o.ConfigurationID)
+}
@@ -0,0 +1,1250 @@
+// Code generated by SQLBoiler 4.17.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MachineMove is an object representing the database table.
+type MachineMove struct {
+	ID              int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID int64     `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	SerialNumber    string    `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	MachineID       string    `boil:"machine_id" json:"machine_id" toml:"machine_id" yaml:"machine_id"`
+	FromGroupID     string    `boil:"from_group_id" json:"from_group_id" toml:"from_group_id" yaml:"from_group_id"`
+	ToGroupID       string    `boil:"to_group_id" json:"to_group_id" toml:"to_group_id" yaml:"to_group_id"`
+	MovedAt         time.Time `boil:"moved_at" json:"moved_at" toml:"moved_at" yaml:"moved_at"`
+
+	R *machineMoveR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L machineMoveL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MachineMoveColumns = struct {
+	ID              string
+	ConfigurationID string
+	SerialNumber    string
+	MachineID       string
+	FromGroupID     string
+	ToGroupID       string
+	MovedAt         string
+}{
+	ID:              "id",
+	ConfigurationID: "configuration_id",
+	SerialNumber:    "serial_number",
+	MachineID:       "machine_id",
+	FromGroupID:     "from_group_id",
+	ToGroupID:       "to_group_id",
+	MovedAt:         "moved_at",
+}
+
+var MachineMoveTableColumns = struct {
+	ID              string
+	ConfigurationID string
+	SerialNumber    string
+	MachineID       string
+	FromGroupID     string
+	ToGroupID       string
+	MovedAt         string
+}{
+	ID:              "machine_move.id",
+	ConfigurationID: "machine_move.configuration_id",
+	SerialNumber:    "machine_move.serial_number",
+	MachineID:       "machine_move.machine_id",
+	FromGroupID:     "machine_move.from_group_id",
+	ToGroupID:       "machine_move.to_group_id",
+	MovedAt:         "machine_move.moved_at",
+}
+
+// Generated where
+
+var MachineMoveWhere = struct {
+	ID              whereHelperint64
+	ConfigurationID whereHelperint64
+	SerialNumber    whereHelperstring
+	MachineID       whereHelperstring
+	FromGroupID     whereHelperstring
+	ToGroupID       whereHelperstring
+	MovedAt         whereHelpertime_Time
+}{
+	ID:              whereHelperint64{field: "\"coffeecloud\".\"machine_move\".\"id\""},
+	ConfigurationID: whereHelperint64{field: "\"coffeecloud\".\"machine_move\".\"configuration_id\""},
+	SerialNumber:    whereHelperstring{field: "\"coffeecloud\".\"machine_move\".\"serial_number\""},
+	MachineID:       whereHelperstring{field: "\"coffeecloud\".\"machine_move\".\"machine_id\""},
+	FromGroupID:     whereHelperstring{field: "\"coffeecloud\".\"machine_move\".\"from_group_id\""},
+	ToGroupID:       whereHelperstring{field: "\"coffeecloud\".\"machine_move\".\"to_group_id\""},
+	MovedAt:         whereHelpertime_Time{field: "\"coffeecloud\".\"machine_move\".\"moved_at\""},
+}
+
+// MachineMoveRels is where relationship names are stored.
+var MachineMoveRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// machineMoveR is where relationships are stored.
+type machineMoveR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*machineMoveR) NewStruct() *machineMoveR {
+	return &machineMoveR{}
+}
+
+func (r *machineMoveR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// machineMoveL is where Load methods for each relationship are stored.
+type machineMoveL struct{}
+
+var (
+	machineMoveAllColumns            = []string{"id", "configuration_id", "serial_number", "machine_id", "from_group_id", "to_group_id", "moved_at"}
+	machineMoveColumnsWithoutDefault = []string{"configuration_id", "serial_number", "machine_id", "from_group_id", "to_group_id", "moved_at"}
+	machineMoveColumnsWithDefault    = []string{"id"}
+	machineMovePrimaryKeyColumns     = []string{"id"}
+	machineMoveGeneratedColumns      = []string{}
+)
+
+type (
+	// MachineMoveSlice is an alias for a slice of pointers to MachineMove.
+	// This should almost always be used instead of []MachineMove.
+	MachineMoveSlice []*MachineMove
+	// MachineMoveHook is the signature for custom MachineMove hook methods
+	MachineMoveHook func(context.Context, boil.ContextExecutor, *MachineMove) error
+
+	machineMoveQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	machineMoveType                 = reflect.TypeOf(&MachineMove{})
+	machineMoveMapping              = queries.MakeStructMapping(machineMoveType)
+	machineMovePrimaryKeyMapping, _ = queries.BindMapping(machineMoveType, machineMoveMapping, machineMovePrimaryKeyColumns)
+	machineMoveInsertCacheMut       sync.RWMutex
+	machineMoveInsertCache          = make(map[string]insertCache)
+	machineMoveUpdateCacheMut       sync.RWMutex
+	machineMoveUpdateCache          = make(map[string]updateCache)
+	machineMoveUpsertCacheMut       sync.RWMutex
+	machineMoveUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var machineMoveAfterSelectMu sync.Mutex
+var machineMoveAfterSelectHooks []MachineMoveHook
+
+var machineMoveBeforeInsertMu sync.Mutex
+var machineMoveBeforeInsertHooks []MachineMoveHook
+var machineMoveAfterInsertMu sync.Mutex
+var machineMoveAfterInsertHooks []MachineMoveHook
+
+var machineMoveBeforeUpdateMu sync.Mutex
+var machineMoveBeforeUpdateHooks []MachineMoveHook
+var machineMoveAfterUpdateMu sync.Mutex
+var machineMoveAfterUpdateHooks []MachineMoveHook
+
+var machineMoveBeforeDeleteMu sync.Mutex
+var machineMoveBeforeDeleteHooks []MachineMoveHook
+var machineMoveAfterDeleteMu sync.Mutex
+var machineMoveAfterDeleteHooks []MachineMoveHook
+
+var machineMoveBeforeUpsertMu sync.Mutex
+var machineMoveBeforeUpsertHooks []MachineMoveHook
+var machineMoveAfterUpsertMu sync.Mutex
+var machineMoveAfterUpsertHooks []MachineMoveHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MachineMove) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineMoveAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MachineMove) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineMoveBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MachineMove) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineMoveAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MachineMove) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineMoveBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MachineMove) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineMoveAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MachineMove) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineMoveBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MachineMove) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineMoveAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MachineMove) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineMoveBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MachineMove) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineMoveAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMachineMoveHook registers your hook function for all future operations.
+func AddMachineMoveHook(hookPoint boil.HookPoint, machineMoveHook MachineMoveHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		machineMoveAfterSelectMu.Lock()
+		machineMoveAfterSelectHooks = append(machineMoveAfterSelectHooks, machineMoveHook)
+		machineMoveAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		machineMoveBeforeInsertMu.Lock()
+		machineMoveBeforeInsertHooks = append(machineMoveBeforeInsertHooks, machineMoveHook)
+		machineMoveBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		machineMoveAfterInsertMu.Lock()
+		machineMoveAfterInsertHooks = append(machineMoveAfterInsertHooks, machineMoveHook)
+		machineMoveAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		machineMoveBeforeUpdateMu.Lock()
+		machineMoveBeforeUpdateHooks = append(machineMoveBeforeUpdateHooks, machineMoveHook)
+		machineMoveBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		machineMoveAfterUpdateMu.Lock()
+		machineMoveAfterUpdateHooks = append(machineMoveAfterUpdateHooks, machineMoveHook)
+		machineMoveAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		machineMoveBeforeDeleteMu.Lock()
+		machineMoveBeforeDeleteHooks = append(machineMoveBeforeDeleteHooks, machineMoveHook)
+		machineMoveBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		machineMoveAfterDeleteMu.Lock()
+		machineMoveAfterDeleteHooks = append(machineMoveAfterDeleteHooks, machineMoveHook)
+		machineMoveAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		machineMoveBeforeUpsertMu.Lock()
+		machineMoveBeforeUpsertHooks = append(machineMoveBeforeUpsertHooks, machineMoveHook)
+		machineMoveBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		machineMoveAfterUpsertMu.Lock()
+		machineMoveAfterUpsertHooks = append(machineMoveAfterUpsertHooks, machineMoveHook)
+		machineMoveAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single machineMove record from the query using the global executor.
+func (q machineMoveQuery) OneG(ctx context.Context) (*MachineMove, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single machineMove record from the query.
+func (q machineMoveQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MachineMove, error) {
+	o := &MachineMove{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for machine_move")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all MachineMove records from the query using the global executor.
+func (q machineMoveQuery) AllG(ctx context.Context) (MachineMoveSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all MachineMove records from the query.
+func (q machineMoveQuery) All(ctx context.Context, exec boil.ContextExecutor) (MachineMoveSlice, error) {
+	var o []*MachineMove
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to MachineMove slice")
+	}
+
+	if len(machineMoveAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all MachineMove records in the query using the global executor
+func (q machineMoveQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all MachineMove records in the query.
+func (q machineMoveQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count machine_move rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q machineMoveQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q machineMoveQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if machine_move exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *MachineMove) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (machineMoveL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMachineMove interface{}, mods queries.Applicator) error {
+	var slice []*MachineMove
+	var object *MachineMove
+
+	if singular {
+		var ok bool
+		object, ok = maybeMachineMove.(*MachineMove)
+		if !ok {
+			object = new(MachineMove)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMachineMove)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMachineMove))
+			}
+		}
+	} else {
+		s, ok := maybeMachineMove.(*[]*MachineMove)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMachineMove)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMachineMove))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &machineMoveR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &machineMoveR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`coffeecloud.configuration`),
+		qm.WhereIn(`coffeecloud.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.MachineMoves = append(foreign.R.MachineMoves, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.MachineMoves = append(foreign.R.MachineMoves, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the machineMove to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.MachineMoves.
+// Uses the global database handle.
+func (o *MachineMove) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the machineMove to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.MachineMoves.
+func (o *MachineMove) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"coffeecloud\".\"machine_move\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, machineMovePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &machineMoveR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			MachineMoves: MachineMoveSlice{o},
+		}
+	} else {
+		related.R.MachineMoves = append(related.R.MachineMoves, o)
+	}
+
+	return nil
+}
+
+// MachineMoves retrieves all the records using an executor.
+func MachineMoves(mods ...qm.QueryMod) machineMoveQuery {
+	mods = append(mods, qm.From("\"coffeecloud\".\"machine_move\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"coffeecloud\".\"machine_move\".*"})
+	}
+
+	return machineMoveQuery{q}
+}
+
+// FindMachineMoveG retrieves a single record by ID.
+func FindMachineMoveG(ctx context.Context, iD int64, selectCols ...string) (*MachineMove, error) {
+	return FindMachineMove(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindMachineMove retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMachineMove(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*MachineMove, error) {
+	machineMoveObj := &MachineMove{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"coffeecloud\".\"machine_move\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, machineMoveObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from machine_move")
+	}
+
+	if err = machineMoveObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return machineMoveObj, err
+	}
+
+	return machineMoveObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *MachineMove) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MachineMove) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no machine_move provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(machineMoveColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	machineMoveInsertCacheMut.RLock()
+	cache, cached := machineMoveInsertCache[key]
+	machineMoveInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			machineMoveAllColumns,
+			machineMoveColumnsWithDefault,
+			machineMoveColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(machineMoveType, machineMoveMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(machineMoveType, machineMoveMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"coffeecloud\".\"machine_move\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"coffeecloud\".\"machine_move\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into machine_move")
+	}
+
+	if !cached {
+		machineMoveInsertCacheMut.Lock()
+		machineMoveInsertCache[key] = cache
+		machineMoveInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single MachineMove record using the global executor.
+// See Update for more documentation.
+func (o *MachineMove) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the MachineMove.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MachineMove) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	machineMoveUpdateCacheMut.RLock()
+	cache, cached := machineMoveUpdateCache[key]
+	machineMoveUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			machineMoveAllColumns,
+			machineMovePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update machine_move, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"coffeecloud\".\"machine_move\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, machineMovePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(machineMoveType, machineMoveMapping, append(wl, machineMovePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update machine_move row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for machine_move")
+	}
+
+	if !cached {
+		machineMoveUpdateCacheMut.Lock()
+		machineMoveUpdateCache[key] = cache
+		machineMoveUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q machineMoveQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q machineMoveQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for machine_move")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for machine_move")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o MachineMoveSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MachineMoveSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineMovePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"coffeecloud\".\"machine_move\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, machineMovePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in machineMove slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all machineMove")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *MachineMove) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MachineMove) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no machine_move provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(machineMoveColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	machineMoveUpsertCacheMut.RLock()
+	cache, cached := machineMoveUpsertCache[key]
+	machineMoveUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			machineMoveAllColumns,
+			machineMoveColumnsWithDefault,
+			machineMoveColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			machineMoveAllColumns,
+			machineMovePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert machine_move, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(machineMoveAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(machineMovePrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert machine_move, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(machineMovePrimaryKeyColumns))
+			copy(conflict, machineMovePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"coffeecloud\".\"machine_move\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(machineMoveType, machineMoveMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(machineMoveType, machineMoveMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert machine_move")
+	}
+
+	if !cached {
+		machineMoveUpsertCacheMut.Lock()
+		machineMoveUpsertCache[key] = cache
+		machineMoveUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single MachineMove record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *MachineMove) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single MachineMove record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MachineMove) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no MachineMove provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), machineMovePrimaryKeyMapping)
+	sql := "DELETE FROM \"coffeecloud\".\"machine_move\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from machine_move")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for machine_move")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q machineMoveQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q machineMoveQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no machineMoveQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from machine_move")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for machine_move")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o MachineMoveSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MachineMoveSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(machineMoveBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineMovePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"coffeecloud\".\"machine_move\" WHERE " +
+		strmangle.WhereInClause(string(dialect.LQ), string(dialect.RQ), 1, machineMovePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from machineMove slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for machine_move")
+	}
+
+	if len(machineMoveAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *MachineMove) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no MachineMove provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MachineMove) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMachineMove(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MachineMoveSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty MachineMoveSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MachineMoveSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MachineMoveSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineMovePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"coffeecloud\".\"machine_move\".* FROM \"coffeecloud\".\"machine_move\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, machineMovePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in MachineMoveSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MachineMoveExistsG checks if the MachineMove row exists.
+func MachineMoveExistsG(ctx context.Context, iD int64) (bool, error) {
+	return MachineMoveExists(ctx, boil.GetContextDB(), iD)
+}
+
+// MachineMoveExists checks if the MachineMove row exists.
+func MachineMoveExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"coffeecloud\".\"machine_move\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if machine_move exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MachineMove row exists.
+func (o *MachineMove) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MachineMoveExists(ctx, exec, o.ID)
+}
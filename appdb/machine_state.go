@@ -0,0 +1,1237 @@
+// Code generated by SQLBoiler 4.17.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MachineState is an object representing the database table.
+type MachineState struct {
+	ID                int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID   int64       `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	SerialNumber      string      `boil:"serial_number" json:"serial_number" toml:"serial_number" yaml:"serial_number"`
+	HoursSinceCleaned int32       `boil:"hours_since_cleaned" json:"hours_since_cleaned" toml:"hours_since_cleaned" yaml:"hours_since_cleaned"`
+	GroupID           null.String `boil:"group_id" json:"group_id,omitempty" toml:"group_id" yaml:"group_id,omitempty"`
+
+	R *machineStateR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L machineStateL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MachineStateColumns = struct {
+	ID                string
+	ConfigurationID   string
+	SerialNumber      string
+	HoursSinceCleaned string
+	GroupID           string
+}{
+	ID:                "id",
+	ConfigurationID:   "configuration_id",
+	SerialNumber:      "serial_number",
+	HoursSinceCleaned: "hours_since_cleaned",
+	GroupID:           "group_id",
+}
+
+var MachineStateTableColumns = struct {
+	ID                string
+	ConfigurationID   string
+	SerialNumber      string
+	HoursSinceCleaned string
+	GroupID           string
+}{
+	ID:                "machine_state.id",
+	ConfigurationID:   "machine_state.configuration_id",
+	SerialNumber:      "machine_state.serial_number",
+	HoursSinceCleaned: "machine_state.hours_since_cleaned",
+	GroupID:           "machine_state.group_id",
+}
+
+// Generated where
+
+var MachineStateWhere = struct {
+	ID                whereHelperint64
+	ConfigurationID   whereHelperint64
+	SerialNumber      whereHelperstring
+	HoursSinceCleaned whereHelperint32
+	GroupID           whereHelpernull_String
+}{
+	ID:                whereHelperint64{field: "\"coffeecloud\".\"machine_state\".\"id\""},
+	ConfigurationID:   whereHelperint64{field: "\"coffeecloud\".\"machine_state\".\"configuration_id\""},
+	SerialNumber:      whereHelperstring{field: "\"coffeecloud\".\"machine_state\".\"serial_number\""},
+	HoursSinceCleaned: whereHelperint32{field: "\"coffeecloud\".\"machine_state\".\"hours_since_cleaned\""},
+	GroupID:           whereHelpernull_String{field: "\"coffeecloud\".\"machine_state\".\"group_id\""},
+}
+
+// MachineStateRels is where relationship names are stored.
+var MachineStateRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// machineStateR is where relationships are stored.
+type machineStateR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*machineStateR) NewStruct() *machineStateR {
+	return &machineStateR{}
+}
+
+func (r *machineStateR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// machineStateL is where Load methods for each relationship are stored.
+type machineStateL struct{}
+
+var (
+	machineStateAllColumns            = []string{"id", "configuration_id", "serial_number", "hours_since_cleaned", "group_id"}
+	machineStateColumnsWithoutDefault = []string{"configuration_id", "serial_number", "hours_since_cleaned"}
+	machineStateColumnsWithDefault    = []string{"id", "group_id"}
+	machineStatePrimaryKeyColumns     = []string{"id"}
+	machineStateGeneratedColumns      = []string{}
+)
+
+type (
+	// MachineStateSlice is an alias for a slice of pointers to MachineState.
+	// This should almost always be used instead of []MachineState.
+	MachineStateSlice []*MachineState
+	// MachineStateHook is the signature for custom MachineState hook methods
+	MachineStateHook func(context.Context, boil.ContextExecutor, *MachineState) error
+
+	machineStateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	machineStateType                 = reflect.TypeOf(&MachineState{})
+	machineStateMapping              = queries.MakeStructMapping(machineStateType)
+	machineStatePrimaryKeyMapping, _ = queries.BindMapping(machineStateType, machineStateMapping, machineStatePrimaryKeyColumns)
+	machineStateInsertCacheMut       sync.RWMutex
+	machineStateInsertCache          = make(map[string]insertCache)
+	machineStateUpdateCacheMut       sync.RWMutex
+	machineStateUpdateCache          = make(map[string]updateCache)
+	machineStateUpsertCacheMut       sync.RWMutex
+	machineStateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var machineStateAfterSelectMu sync.Mutex
+var machineStateAfterSelectHooks []MachineStateHook
+
+var machineStateBeforeInsertMu sync.Mutex
+var machineStateBeforeInsertHooks []MachineStateHook
+var machineStateAfterInsertMu sync.Mutex
+var machineStateAfterInsertHooks []MachineStateHook
+
+var machineStateBeforeUpdateMu sync.Mutex
+var machineStateBeforeUpdateHooks []MachineStateHook
+var machineStateAfterUpdateMu sync.Mutex
+var machineStateAfterUpdateHooks []MachineStateHook
+
+var machineStateBeforeDeleteMu sync.Mutex
+var machineStateBeforeDeleteHooks []MachineStateHook
+var machineStateAfterDeleteMu sync.Mutex
+var machineStateAfterDeleteHooks []MachineStateHook
+
+var machineStateBeforeUpsertMu sync.Mutex
+var machineStateBeforeUpsertHooks []MachineStateHook
+var machineStateAfterUpsertMu sync.Mutex
+var machineStateAfterUpsertHooks []MachineStateHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MachineState) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineStateAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MachineState) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineStateBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MachineState) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineStateAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MachineState) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineStateBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MachineState) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineStateAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MachineState) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineStateBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MachineState) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineStateAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MachineState) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineStateBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MachineState) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range machineStateAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMachineStateHook registers your hook function for all future operations.
+func AddMachineStateHook(hookPoint boil.HookPoint, machineStateHook MachineStateHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		machineStateAfterSelectMu.Lock()
+		machineStateAfterSelectHooks = append(machineStateAfterSelectHooks, machineStateHook)
+		machineStateAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		machineStateBeforeInsertMu.Lock()
+		machineStateBeforeInsertHooks = append(machineStateBeforeInsertHooks, machineStateHook)
+		machineStateBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		machineStateAfterInsertMu.Lock()
+		machineStateAfterInsertHooks = append(machineStateAfterInsertHooks, machineStateHook)
+		machineStateAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		machineStateBeforeUpdateMu.Lock()
+		machineStateBeforeUpdateHooks = append(machineStateBeforeUpdateHooks, machineStateHook)
+		machineStateBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		machineStateAfterUpdateMu.Lock()
+		machineStateAfterUpdateHooks = append(machineStateAfterUpdateHooks, machineStateHook)
+		machineStateAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		machineStateBeforeDeleteMu.Lock()
+		machineStateBeforeDeleteHooks = append(machineStateBeforeDeleteHooks, machineStateHook)
+		machineStateBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		machineStateAfterDeleteMu.Lock()
+		machineStateAfterDeleteHooks = append(machineStateAfterDeleteHooks, machineStateHook)
+		machineStateAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		machineStateBeforeUpsertMu.Lock()
+		machineStateBeforeUpsertHooks = append(machineStateBeforeUpsertHooks, machineStateHook)
+		machineStateBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		machineStateAfterUpsertMu.Lock()
+		machineStateAfterUpsertHooks = append(machineStateAfterUpsertHooks, machineStateHook)
+		machineStateAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single machineState record from the query using the global executor.
+func (q machineStateQuery) OneG(ctx context.Context) (*MachineState, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single machineState record from the query.
+func (q machineStateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MachineState, error) {
+	o := &MachineState{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for machine_state")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all MachineState records from the query using the global executor.
+func (q machineStateQuery) AllG(ctx context.Context) (MachineStateSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all MachineState records from the query.
+func (q machineStateQuery) All(ctx context.Context, exec boil.ContextExecutor) (MachineStateSlice, error) {
+	var o []*MachineState
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to MachineState slice")
+	}
+
+	if len(machineStateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all MachineState records in the query using the global executor
+func (q machineStateQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all MachineState records in the query.
+func (q machineStateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count machine_state rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q machineStateQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q machineStateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if machine_state exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *MachineState) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (machineStateL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMachineState interface{}, mods queries.Applicator) error {
+	var slice []*MachineState
+	var object *MachineState
+
+	if singular {
+		var ok bool
+		object, ok = maybeMachineState.(*MachineState)
+		if !ok {
+			object = new(MachineState)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMachineState)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMachineState))
+			}
+		}
+	} else {
+		s, ok := maybeMachineState.(*[]*MachineState)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMachineState)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMachineState))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &machineStateR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &machineStateR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`coffeecloud.configuration`),
+		qm.WhereIn(`coffeecloud.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.MachineStates = append(foreign.R.MachineStates, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.MachineStates = append(foreign.R.MachineStates, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the machineState to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.MachineStates.
+// Uses the global database handle.
+func (o *MachineState) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the machineState to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.MachineStates.
+func (o *MachineState) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"coffeecloud\".\"machine_state\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, machineStatePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &machineStateR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			MachineStates: MachineStateSlice{o},
+		}
+	} else {
+		related.R.MachineStates = append(related.R.MachineStates, o)
+	}
+
+	return nil
+}
+
+// MachineStates retrieves all the records using an executor.
+func MachineStates(mods ...qm.QueryMod) machineStateQuery {
+	mods = append(mods, qm.From("\"coffeecloud\".\"machine_state\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"coffeecloud\".\"machine_state\".*"})
+	}
+
+	return machineStateQuery{q}
+}
+
+// FindMachineStateG retrieves a single record by ID.
+func FindMachineStateG(ctx context.Context, iD int64, selectCols ...string) (*MachineState, error) {
+	return FindMachineState(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindMachineState retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMachineState(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*MachineState, error) {
+	machineStateObj := &MachineState{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"coffeecloud\".\"machine_state\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, machineStateObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from machine_state")
+	}
+
+	if err = machineStateObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return machineStateObj, err
+	}
+
+	return machineStateObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *MachineState) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MachineState) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no machine_state provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(machineStateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	machineStateInsertCacheMut.RLock()
+	cache, cached := machineStateInsertCache[key]
+	machineStateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			machineStateAllColumns,
+			machineStateColumnsWithDefault,
+			machineStateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(machineStateType, machineStateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(machineStateType, machineStateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"coffeecloud\".\"machine_state\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"coffeecloud\".\"machine_state\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into machine_state")
+	}
+
+	if !cached {
+		machineStateInsertCacheMut.Lock()
+		machineStateInsertCache[key] = cache
+		machineStateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single MachineState record using the global executor.
+// See Update for more documentation.
+func (o *MachineState) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the MachineState.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MachineState) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	machineStateUpdateCacheMut.RLock()
+	cache, cached := machineStateUpdateCache[key]
+	machineStateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			machineStateAllColumns,
+			machineStatePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update machine_state, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"coffeecloud\".\"machine_state\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, machineStatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(machineStateType, machineStateMapping, append(wl, machineStatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update machine_state row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for machine_state")
+	}
+
+	if !cached {
+		machineStateUpdateCacheMut.Lock()
+		machineStateUpdateCache[key] = cache
+		machineStateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q machineStateQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q machineStateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for machine_state")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for machine_state")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o MachineStateSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MachineStateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"coffeecloud\".\"machine_state\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, machineStatePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in machineState slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all machineState")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *MachineState) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MachineState) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no machine_state provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(machineStateColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	machineStateUpsertCacheMut.RLock()
+	cache, cached := machineStateUpsertCache[key]
+	machineStateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			machineStateAllColumns,
+			machineStateColumnsWithDefault,
+			machineStateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			machineStateAllColumns,
+			machineStatePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert machine_state, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(machineStateAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(machineStatePrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert machine_state, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(machineStatePrimaryKeyColumns))
+			copy(conflict, machineStatePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"coffeecloud\".\"machine_state\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(machineStateType, machineStateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(machineStateType, machineStateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert machine_state")
+	}
+
+	if !cached {
+		machineStateUpsertCacheMut.Lock()
+		machineStateUpsertCache[key] = cache
+		machineStateUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single MachineState record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *MachineState) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single MachineState record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MachineState) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no MachineState provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), machineStatePrimaryKeyMapping)
+	sql := "DELETE FROM \"coffeecloud\".\"machine_state\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from machine_state")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for machine_state")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q machineStateQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q machineStateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no machineStateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from machine_state")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for machine_state")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o MachineStateSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MachineStateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(machineStateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"coffeecloud\".\"machine_state\" WHERE " +
+		strmangle.WhereInClause(string(dialect.LQ), string(dialect.RQ), 1, machineStatePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from machineState slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for machine_state")
+	}
+
+	if len(machineStateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *MachineState) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no MachineState provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MachineState) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMachineState(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MachineStateSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty MachineStateSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MachineStateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MachineStateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), machineStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"coffeecloud\".\"machine_state\".* FROM \"coffeecloud\".\"machine_state\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, machineStatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in MachineStateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MachineStateExistsG checks if the MachineState row exists.
+func MachineStateExistsG(ctx context.Context, iD int64) (bool, error) {
+	return MachineStateExists(ctx, boil.GetContextDB(), iD)
+}
+
+// MachineStateExists checks if the MachineState row exists.
+func MachineStateExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"coffeecloud\".\"machine_state\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if machine_state exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MachineState row exists.
+func (o *MachineState) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MachineStateExists(ctx, exec, o.ID)
+}
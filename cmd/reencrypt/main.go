@@ -0,0 +1,72 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// The reencrypt command encrypts the CoffeeCloud credentials of all configurations with a new
+// credentials key. The current key is read from CREDENTIALS_KEY or CREDENTIALS_KEY_FILE like in
+// the app, the new key from NEW_CREDENTIALS_KEY or the file given by -new-key-file. Credentials
+// stored in plain text are encrypted as well. Afterwards, the app has to be started with the new key.
+package main
+
+import (
+	"coffeecloud/conf"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/eliona-smart-building-assistant/go-eliona/app"
+	"github.com/eliona-smart-building-assistant/go-utils/db"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func main() {
+	newKeyFile := flag.String("new-key-file", "", "file containing the new base64 encoded credentials key")
+	generateKey := flag.Bool("generate-key", false, "print a new random credentials key and exit")
+	flag.Parse()
+
+	if *generateKey {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			log.Fatal("conf", "generating key: %v", err)
+		}
+		fmt.Println(base64.StdEncoding.EncodeToString(key))
+		return
+	}
+
+	oldKey, err := conf.LoadCredentialsKey()
+	if err != nil {
+		log.Fatal("conf", "loading current credentials key: %v", err)
+	}
+	newKey, err := conf.ReadCredentialsKey(os.Getenv("NEW_CREDENTIALS_KEY"), *newKeyFile)
+	if err != nil {
+		log.Fatal("conf", "loading new credentials key: %v", err)
+	}
+	if newKey == nil {
+		log.Fatal("conf", "no new credentials key: set NEW_CREDENTIALS_KEY or use -new-key-file")
+	}
+
+	database := db.Database(app.AppName())
+	defer database.Close()
+	boil.SetDB(database)
+
+	count, err := conf.ReencryptCredentials(context.Background(), oldKey, newKey)
+	if err != nil {
+		log.Fatal("conf", "re-encrypting credentials: %v", err)
+	}
+	log.Info("conf", "Re-encrypted the credentials of %d configurations.", count)
+}
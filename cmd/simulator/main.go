@@ -0,0 +1,73 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// The simulator command serves a fake CoffeeCloud API for local development. Point the
+// url of an app configuration to the simulator and use the simulator credentials.
+package main
+
+import (
+	"coffeecloud/simulator"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+func main() {
+	address := flag.String("address", ":8081", "address to listen on")
+	fleetFile := flag.String("fleet", "", "JSON file with the fleet to serve instead of a generated one")
+	groups := flag.Int("groups", 3, "number of generated top level groups")
+	subGroups := flag.Int("subgroups", 2, "number of generated child groups per group")
+	depth := flag.Int("depth", 1, "number of generated nested group levels")
+	machines := flag.Int("machines", 5, "number of generated machines per group")
+	errors := flag.Int("errors", 3, "number of generated errors per machine")
+	seed := flag.Int64("seed", 1, "seed for the generated fleet")
+	username := flag.String("username", simulator.DefaultUsername, "accepted username")
+	password := flag.String("password", simulator.DefaultPassword, "accepted password")
+	apiKey := flag.String("api-key", simulator.DefaultAPIKey, "accepted API key")
+	tokenLifetime := flag.Duration("token-lifetime", time.Hour, "lifetime of issued tokens")
+	flag.Parse()
+
+	fleet := simulator.GenerateFleet(simulator.FleetOptions{
+		Groups:           *groups,
+		SubGroups:        *subGroups,
+		Depth:            *depth,
+		MachinesPerGroup: *machines,
+		ErrorsPerMachine: *errors,
+		Seed:             *seed,
+	})
+	if *fleetFile != "" {
+		content, err := os.ReadFile(*fleetFile)
+		if err != nil {
+			log.Fatal("simulator", "reading fleet file: %v", err)
+		}
+		fleet = simulator.Fleet{}
+		if err := json.Unmarshal(content, &fleet); err != nil {
+			log.Fatal("simulator", "parsing fleet file: %v", err)
+		}
+	}
+
+	server := simulator.New(fleet)
+	server.SetCredentials(*username, *password, *apiKey)
+	server.SetTokenLifetime(*tokenLifetime)
+
+	log.Info("simulator", "Serving CoffeeCloud simulator on %s", *address)
+	if err := http.ListenAndServe(*address, server); err != nil {
+		log.Fatal("simulator", "serving: %v", err)
+	}
+}
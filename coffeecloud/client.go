@@ -0,0 +1,233 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package coffeecloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/http"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// Client accesses the CoffeeCloud API for one configuration. The client keeps the
+// auth token between calls and logs in again only if the token is expired or rejected.
+type Client struct {
+	url        string
+	apiKey     string
+	username   string
+	password   string
+	timeout    time.Duration
+	httpClient *nethttp.Client
+	ctx        context.Context
+
+	session *session
+	retries *retryBudget
+	limiter *rateLimiter
+}
+
+// session holds the auth token and the search capabilities shared by all clients derived from one client.
+type session struct {
+	mutex sync.Mutex
+	token *cachedToken
+
+	criteriaRejected atomic.Bool
+}
+
+// NewClient creates a client for the given CoffeeCloud instance and credentials.
+func NewClient(url string, apiKey string, username string, password string, timeout time.Duration) *Client {
+	return &Client{
+		url:        url,
+		apiKey:     apiKey,
+		username:   username,
+		password:   password,
+		timeout:    timeout,
+		httpClient: &nethttp.Client{Timeout: timeout},
+		ctx:        context.Background(),
+		session:    &session{},
+		retries:    &retryBudget{},
+		limiter:    &rateLimiter{},
+	}
+}
+
+// WithContext returns a client sharing the auth token and the rate limit with this client. Its
+// requests are canceled with the context, and it has an own retry budget without a deadline.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	derived := *c
+	derived.ctx = ctx
+	derived.retries = &retryBudget{}
+	return &derived
+}
+
+// Matches checks if the client was created for the given access data.
+func (c *Client) Matches(url string, apiKey string, username string, password string, timeout time.Duration) bool {
+	return c.url == url && c.apiKey == apiKey && c.username == username && c.password == password && c.timeout == timeout
+}
+
+// authToken returns the cached token or logs in if there is no valid one.
+func (c *Client) authToken() (string, error) {
+	c.session.mutex.Lock()
+	defer c.session.mutex.Unlock()
+	if c.session.token != nil && !c.session.token.expired() {
+		return c.session.token.idToken, nil
+	}
+	log.Debug("coffeecloud", "logging in to %s as %s", c.url, c.username)
+	idToken, err := c.getAuthToken()
+	if err != nil {
+		return "", fmt.Errorf("getting access token: %w", err)
+	}
+	if idToken == nil || *idToken == "" {
+		return "", fmt.Errorf("no access token received")
+	}
+	c.session.token = newCachedToken(*idToken)
+	return c.session.token.idToken, nil
+}
+
+// invalidateToken drops the cached token, if it is still the given one.
+func (c *Client) invalidateToken(idToken string) {
+	c.session.mutex.Lock()
+	defer c.session.mutex.Unlock()
+	if c.session.token != nil && c.session.token.idToken == idToken {
+		c.session.token = nil
+	}
+}
+
+// read requests the path with the cached auth token and decodes the response.
+func read[T any](c *Client, method string, path string, body any) (T, error) {
+	return decode[T](c.send(method, path, body))
+}
+
+func decode[T any](payload []byte, err error) (T, error) {
+	var value T
+	if err != nil {
+		return value, err
+	}
+	if len(payload) == 0 {
+		return value, nil
+	}
+	if err := json.Unmarshal(payload, &value); err != nil {
+		return value, fmt.Errorf("unmarshalling %T: %w", value, err)
+	}
+	return value, nil
+}
+
+// send requests the path with the cached auth token. If the token is rejected, the
+// client logs in again and repeats the request once.
+func (c *Client) send(method string, path string, body any) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		token, err := c.authToken()
+		if err != nil {
+			return nil, err
+		}
+		payload, err := c.do(func() (*nethttp.Request, error) {
+			return c.newRequest(method, path, body, token)
+		})
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) && statusErr.StatusCode == nethttp.StatusUnauthorized && attempt == 0 {
+			log.Debug("coffeecloud", "token rejected for %s, logging in again", path)
+			c.invalidateToken(token)
+			continue
+		}
+		return payload, err
+	}
+}
+
+// do executes the request created by newRequest. Server errors, network errors and
+// too many requests responses are retried as long as the retry budget allows.
+func (c *Client) do(newRequest func() (*nethttp.Request, error)) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		request, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+		payload, err := c.execute(request.WithContext(c.ctx))
+		if err == nil {
+			return payload, nil
+		}
+		wait, retry := c.retries.next(attempt, err)
+		if !retry {
+			return nil, err
+		}
+		log.Debug("coffeecloud", "retrying request to %s in %v: %v", request.URL, wait, err)
+		if err := sleep(c.ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *Client) execute(request *nethttp.Request) ([]byte, error) {
+	if err := c.limiter.wait(c.ctx); err != nil {
+		return nil, err
+	}
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("request to %s: %w", request.URL, err)
+	}
+	defer response.Body.Close()
+	payload, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response from %s: %w", request.URL, err)
+	}
+	if response.StatusCode >= 300 {
+		return nil, &StatusError{
+			StatusCode: response.StatusCode,
+			URL:        request.URL.String(),
+			RetryAfter: response.Header.Get("Retry-After"),
+		}
+	}
+	return payload, nil
+}
+
+// sleep waits for the duration or until the context is done.
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *Client) newRequest(method string, path string, body any, token string) (*nethttp.Request, error) {
+	headers := map[string]string{
+		"Authorization": "Bearer " + token,
+		"API-Key":       c.apiKey,
+	}
+	if method == nethttp.MethodPost {
+		return http.NewPostRequestWithHeaders(c.url+path, body, headers)
+	}
+	return http.NewRequestWithHeaders(c.url+path, headers)
+}
+
+// StatusError is returned if the CoffeeCloud API responds with an error status code.
+type StatusError struct {
+	StatusCode int
+	URL        string
+	RetryAfter string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("error request code %d for request to %s", e.StatusCode, e.URL)
+}
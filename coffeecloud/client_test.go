@@ -0,0 +1,268 @@
+package coffeecloud
+
+import (
+	"coffeecloud/simulator"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newSimulatedClient(t *testing.T, options simulator.FleetOptions) (*simulator.Simulator, *Client) {
+	t.Helper()
+	sim, server := simulator.NewServer(simulator.GenerateFleet(options))
+	t.Cleanup(server.Close)
+	client := NewClient(server.URL, simulator.DefaultAPIKey, simulator.DefaultUsername, simulator.DefaultPassword, 5*time.Second)
+	client.StartCycle(0)
+	return sim, client
+}
+
+func TestCollectFleet(t *testing.T) {
+	sim, client := newSimulatedClient(t, simulator.FleetOptions{
+		Groups:           2,
+		SubGroups:        2,
+		Depth:            1,
+		MachinesPerGroup: 60,
+		ErrorsPerMachine: 2,
+	})
+
+	groups, err := client.GetGroups()
+	if err != nil {
+		t.Fatalf("getting groups: %v", err)
+	}
+	groups = FlattenGroups(groups)
+	if len(groups) != 6 {
+		t.Fatalf("got %d groups, want 6", len(groups))
+	}
+	if groups[0].Depth != 0 || groups[len(groups)-1].Depth != 1 || groups[len(groups)-1].ParentID == nil {
+		t.Errorf("groups not ordered by depth: %+v", groups)
+	}
+
+	// a top level group contains the machines of its subgroups, needing two pages
+	machines, err := client.GetMachines(groups[0].ID, Criteria{})
+	if err != nil {
+		t.Fatalf("getting machines: %v", err)
+	}
+	if len(machines) != 180 {
+		t.Errorf("got %d machines, want 180", len(machines))
+	}
+	if sim.Requests("/rest/overview/data") != 2 {
+		t.Errorf("got %d machine requests, want 2", sim.Requests("/rest/overview/data"))
+	}
+
+	machineErrors, err := client.GetMachineErrors(groups[0].ID, Criteria{})
+	if err != nil {
+		t.Fatalf("getting machine errors: %v", err)
+	}
+	for serialNumber, errs := range machineErrors {
+		if len(errs) != 2 {
+			t.Errorf("got %d errors for %s, want 2", len(errs), serialNumber)
+		}
+		if errs[0].Time().Before(errs[1].Time()) {
+			t.Errorf("errors of %s not ordered latest first", serialNumber)
+		}
+	}
+
+	healthStatuses, err := client.GetHealthStatuses(groups[0].ID)
+	if err != nil {
+		t.Fatalf("getting health statuses: %v", err)
+	}
+	if len(healthStatuses) != 180 {
+		t.Errorf("got %d health statuses, want 180", len(healthStatuses))
+	}
+
+	if sim.Logins() != 1 {
+		t.Errorf("got %d logins, want 1", sim.Logins())
+	}
+}
+
+func TestCriteria(t *testing.T) {
+	_, client := newSimulatedClient(t, simulator.FleetOptions{
+		Groups:           1,
+		MachinesPerGroup: 5,
+		ErrorsPerMachine: 10,
+	})
+
+	machines, err := client.GetMachines(1, Criteria{SerialNumbers: []string{"SN00002", "SN00004"}})
+	if err != nil {
+		t.Fatalf("getting machines: %v", err)
+	}
+	if len(machines) != 2 {
+		t.Errorf("got %d machines, want 2", len(machines))
+	}
+
+	all, err := client.GetMachineErrors(1, Criteria{SerialNumbers: []string{"SN00001"}})
+	if err != nil {
+		t.Fatalf("getting machine errors: %v", err)
+	}
+	latest := all["SN00001"][0].Time()
+	recent, err := client.GetMachineErrors(1, Criteria{SerialNumbers: []string{"SN00001"}, From: &latest})
+	if err != nil {
+		t.Fatalf("getting recent machine errors: %v", err)
+	}
+	if len(recent["SN00001"]) == 0 || len(recent["SN00001"]) >= len(all["SN00001"]) {
+		t.Errorf("got %d recent errors of %d", len(recent["SN00001"]), len(all["SN00001"]))
+	}
+}
+
+func TestRejectedCriteria(t *testing.T) {
+	sim, client := newSimulatedClient(t, simulator.FleetOptions{
+		Groups:           1,
+		MachinesPerGroup: 5,
+		ErrorsPerMachine: 10,
+	})
+
+	sim.InjectFault(simulator.Fault{Path: "/rest/overview/data", StatusCode: http.StatusBadRequest, Count: 1})
+	machines, err := client.GetMachines(1, Criteria{SerialNumbers: []string{"SN00002", "SN00004"}})
+	if err != nil {
+		t.Fatalf("getting machines: %v", err)
+	}
+	if len(machines) != 2 {
+		t.Errorf("got %d machines, want 2", len(machines))
+	}
+	if sim.Requests("/rest/overview/data") != 2 {
+		t.Errorf("got %d machine requests, want 2", sim.Requests("/rest/overview/data"))
+	}
+
+	// the criteria are not sent anymore, so the errors are filtered locally without a rejection
+	all, err := client.GetMachineErrors(1, Criteria{})
+	if err != nil {
+		t.Fatalf("getting machine errors: %v", err)
+	}
+	latest := all["SN00001"][0].Time()
+	recent, err := client.GetMachineErrors(1, Criteria{SerialNumbers: []string{"SN00001"}, From: &latest})
+	if err != nil {
+		t.Fatalf("getting recent machine errors: %v", err)
+	}
+	if len(recent) != 1 || len(recent["SN00001"]) == 0 || len(recent["SN00001"]) >= len(all["SN00001"]) {
+		t.Errorf("got %d recent errors of %d", len(recent["SN00001"]), len(all["SN00001"]))
+	}
+	if sim.Requests("/rest/dashboard/error/search") != 2 {
+		t.Errorf("got %d error requests, want 2", sim.Requests("/rest/dashboard/error/search"))
+	}
+}
+
+func TestRejectedToken(t *testing.T) {
+	sim, client := newSimulatedClient(t, simulator.FleetOptions{Groups: 1})
+
+	if _, err := client.GetGroups(); err != nil {
+		t.Fatalf("getting groups: %v", err)
+	}
+	sim.RevokeTokens()
+	if _, err := client.GetGroups(); err != nil {
+		t.Fatalf("getting groups after revoking tokens: %v", err)
+	}
+	if sim.Logins() != 2 {
+		t.Errorf("got %d logins, want 2", sim.Logins())
+	}
+}
+
+func TestExpiredToken(t *testing.T) {
+	sim, client := newSimulatedClient(t, simulator.FleetOptions{Groups: 1})
+	sim.SetTokenLifetime(expirySkew / 2)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetGroups(); err != nil {
+			t.Fatalf("getting groups: %v", err)
+		}
+	}
+	if sim.Logins() != 2 {
+		t.Errorf("got %d logins, want 2", sim.Logins())
+	}
+}
+
+func TestRetries(t *testing.T) {
+	sim, client := newSimulatedClient(t, simulator.FleetOptions{Groups: 1})
+
+	sim.InjectFault(simulator.Fault{Path: "/rest/groups", StatusCode: http.StatusTooManyRequests, RetryAfter: "0", Count: 2})
+	sim.InjectFault(simulator.Fault{Path: "/rest/groups", StatusCode: http.StatusInternalServerError, Count: 1})
+	if _, err := client.GetGroups(); err != nil {
+		t.Fatalf("getting groups: %v", err)
+	}
+	if sim.Requests("/rest/groups") != 4 {
+		t.Errorf("got %d requests, want 4", sim.Requests("/rest/groups"))
+	}
+
+	sim.InjectFault(simulator.Fault{Path: "/rest/groups", StatusCode: http.StatusBadRequest, Count: 1})
+	if _, err := client.GetGroups(); err == nil {
+		t.Errorf("expected client errors not to be retried")
+	}
+}
+
+func TestSlowResponse(t *testing.T) {
+	sim, server := simulator.NewServer(simulator.GenerateFleet(simulator.FleetOptions{Groups: 1}))
+	t.Cleanup(server.Close)
+	client := NewClient(server.URL, simulator.DefaultAPIKey, simulator.DefaultUsername, simulator.DefaultPassword, 100*time.Millisecond)
+	client.StartCycle(0)
+
+	sim.InjectFault(simulator.Fault{Path: "/rest/groups", Delay: time.Second, Count: 1})
+	if _, err := client.GetGroups(); err != nil {
+		t.Fatalf("getting groups after timeout: %v", err)
+	}
+	if sim.Requests("/rest/groups") != 2 {
+		t.Errorf("got %d requests, want 2", sim.Requests("/rest/groups"))
+	}
+}
+
+func TestRetryBudget(t *testing.T) {
+	sim, client := newSimulatedClient(t, simulator.FleetOptions{Groups: 1})
+	client.StartCycle(time.Millisecond)
+
+	sim.InjectFault(simulator.Fault{Path: "/rest/groups", StatusCode: http.StatusTooManyRequests, RetryAfter: "60"})
+	if _, err := client.GetGroups(); err == nil {
+		t.Errorf("expected retry beyond the cycle to be skipped")
+	}
+	if sim.Requests("/rest/groups") != 1 {
+		t.Errorf("got %d requests, want 1", sim.Requests("/rest/groups"))
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	sim, client := newSimulatedClient(t, simulator.FleetOptions{Groups: 1})
+	if _, err := client.GetGroups(); err != nil {
+		t.Fatalf("getting groups: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	derived := client.WithContext(ctx)
+	if _, err := derived.GetGroups(); err != nil {
+		t.Fatalf("getting groups with context: %v", err)
+	}
+	if sim.Logins() != 1 {
+		t.Errorf("got %d logins, want the token to be shared", sim.Logins())
+	}
+
+	sim.InjectFault(simulator.Fault{Path: "/rest/groups", Delay: time.Second, Count: 1})
+	start := time.Now()
+	if _, err := derived.GetGroups(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("request not canceled with the context after %v", time.Since(start))
+	}
+}
+
+func TestCheckBudget(t *testing.T) {
+	limiter := &rateLimiter{interval: time.Second}
+	steps := []struct {
+		name        string
+		groups      int
+		wantOver    bool
+		wantChanged bool
+	}{
+		{"within budget", 1, false, false},
+		{"over budget", 30, true, true},
+		{"still over budget", 30, true, false},
+		{"more groups", 40, true, true},
+		{"within budget again", 1, false, true},
+		{"still within budget", 1, false, false},
+	}
+	for _, step := range steps {
+		_, _, over, changed := limiter.checkBudget(step.groups, time.Minute)
+		if over != step.wantOver || changed != step.wantChanged {
+			t.Errorf("%s: got over %v, changed %v, want %v, %v", step.name, over, changed, step.wantOver, step.wantChanged)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package coffeecloud
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Criteria restricts the records returned by the CoffeeCloud search endpoints. Empty
+// criteria return all records.
+type Criteria struct {
+	// SerialNumbers returns only records of the machines with these serial numbers.
+	SerialNumbers []string
+	// From returns only records with a timestamp at or after this time (error search only).
+	From *time.Time
+	// To returns only records with a timestamp before this time (error search only).
+	To *time.Time
+}
+
+// MarshalJSON encodes the criteria as CoffeeCloud search query on the record fields.
+func (c Criteria) MarshalJSON() ([]byte, error) {
+	criteria := make(map[string]any)
+	if len(c.SerialNumbers) > 0 {
+		criteria["origin.sn"] = map[string]any{
+			"$in": c.SerialNumbers,
+		}
+	}
+	if c.From != nil || c.To != nil {
+		timestamp := make(map[string]any)
+		if c.From != nil {
+			timestamp["$gte"] = c.From.UnixMilli()
+		}
+		if c.To != nil {
+			timestamp["$lt"] = c.To.UnixMilli()
+		}
+		criteria["timestamp.milliseconds"] = timestamp
+	}
+	return json.Marshal(criteria)
+}
+
+func (c Criteria) empty() bool {
+	return len(c.SerialNumbers) == 0 && c.From == nil && c.To == nil
+}
+
+// matchesSerialNumber applies the serial number criterion locally.
+func (c Criteria) matchesSerialNumber(serialNumber string) bool {
+	if len(c.SerialNumbers) == 0 {
+		return true
+	}
+	for _, sn := range c.SerialNumbers {
+		if sn == serialNumber {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesTime applies the timestamp criteria locally.
+func (c Criteria) matchesTime(t time.Time) bool {
+	return (c.From == nil || !t.Before(*c.From)) && (c.To == nil || t.Before(*c.To))
+}
@@ -16,16 +16,21 @@
 package coffeecloud
 
 import (
+	"errors"
+	nethttp "net/http"
 	"strconv"
 	"time"
 
-	"github.com/eliona-smart-building-assistant/go-utils/http"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
 
 type CoffeeGroup struct {
-	ID            uint     `json:"id"`
-	Name          string   `json:"name"`
-	SerialNumbers []string `json:"serialNumbers"`
+	ID            uint          `json:"id"`
+	Name          string        `json:"name"`
+	ParentID      *uint         `json:"parentId"`
+	SerialNumbers []string      `json:"serialNumbers"`
+	Children      []CoffeeGroup `json:"children"`
+	Depth         int           `json:"-"`
 }
 
 type Meta[T any] struct {
@@ -56,6 +61,14 @@ type MachineError struct {
 	Origin     struct {
 		SerialNumber string `json:"sn"`
 	} `json:"origin"`
+	Timestamp struct {
+		Milliseconds int64 `json:"milliseconds"`
+	} `json:"timestamp"`
+}
+
+// Time returns the time the error occurred.
+func (e MachineError) Time() time.Time {
+	return time.UnixMilli(e.Timestamp.Milliseconds)
 }
 
 type HealthMeta struct {
@@ -74,50 +87,50 @@ type HealthStatus struct {
 
 type Body struct {
 	Count    bool           `json:"count"`
-	Criteria struct{}       `json:"criteria"`
+	Criteria Criteria       `json:"criteria"`
 	Limit    int            `json:"limit"`
 	Offset   int            `json:"offset"`
 	Sort     map[string]any `json:"sort"`
 }
 
-func GetGroups(url string, apiKey string, token string, timeout time.Duration) ([]CoffeeGroup, error) {
-	request, err := http.NewRequestWithHeaders(url+"/rest/groups", map[string]string{
-		"Authorization": "Bearer " + token,
-		"API-Key":       apiKey,
-	})
-	if err != nil {
-		return nil, err
+func (c *Client) GetGroups() ([]CoffeeGroup, error) {
+	return read[[]CoffeeGroup](c, "GET", "/rest/groups", nil)
+}
+
+// GetMachines returns the machines of the group matching the criteria by serial number.
+func (c *Client) GetMachines(groupId uint, criteria Criteria) (map[string]CoffeeMachine, error) {
+	sent := c.searchCriteria(criteria)
+	machines, err := c.getMachines(groupId, sent)
+	if c.rejectsCriteria(err, sent) {
+		machines, err = c.getMachines(groupId, Criteria{})
 	}
-	groups, err := http.Read[[]CoffeeGroup](request, timeout, true)
 	if err != nil {
 		return nil, err
 	}
-	return groups, nil
+	for serialNumber := range machines {
+		if !criteria.matchesSerialNumber(serialNumber) {
+			delete(machines, serialNumber)
+		}
+	}
+	return machines, nil
 }
 
-func GetMachines(url string, apiKey string, token string, groupId uint, timeout time.Duration) (map[string]CoffeeMachine, error) {
+func (c *Client) getMachines(groupId uint, criteria Criteria) (map[string]CoffeeMachine, error) {
 	machines := make(map[string]CoffeeMachine)
 	offset := 0
 	limit := 100
 	for {
-		request, err := http.NewPostRequestWithHeaders(url+"/rest/overview/data?groupid="+strconv.Itoa(int(groupId)),
+		meta, err := read[Meta[CoffeeMachine]](c, "POST", "/rest/overview/data?groupid="+strconv.Itoa(int(groupId)),
 			Body{
-				Count:  true,
-				Limit:  limit,
-				Offset: offset,
-			},
-			map[string]string{
-				"Authorization": "Bearer " + token,
-				"API-Key":       apiKey,
+				Count:    true,
+				Criteria: criteria,
+				Limit:    limit,
+				Offset:   offset,
 			},
 		)
 		if err != nil {
 			return nil, err
 		}
-		meta, err := http.Read[Meta[CoffeeMachine]](request, timeout, true)
-		if err != nil {
-			return nil, err
-		}
 		for _, machine := range meta.Result {
 			serialNumber := machine.Origin.SerialNumber
 			if _, exists := machines[serialNumber]; !exists {
@@ -132,37 +145,54 @@ func GetMachines(url string, apiKey string, token string, groupId uint, timeout
 	return machines, nil
 }
 
-func GetMachineErrors(url string, apiKey string, token string, groupId uint, timeout time.Duration) (map[string]MachineError, error) {
-	machineErrors := make(map[string]MachineError)
+// GetMachineErrors returns all errors matching the criteria by serial number, the latest error first.
+func (c *Client) GetMachineErrors(groupId uint, criteria Criteria) (map[string][]MachineError, error) {
+	sent := c.searchCriteria(criteria)
+	machineErrors, err := c.getMachineErrors(groupId, sent)
+	if c.rejectsCriteria(err, sent) {
+		machineErrors, err = c.getMachineErrors(groupId, Criteria{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	for serialNumber, errs := range machineErrors {
+		var matching []MachineError
+		for _, machineError := range errs {
+			if criteria.matchesSerialNumber(serialNumber) && criteria.matchesTime(machineError.Time()) {
+				matching = append(matching, machineError)
+			}
+		}
+		if len(matching) == 0 {
+			delete(machineErrors, serialNumber)
+		} else {
+			machineErrors[serialNumber] = matching
+		}
+	}
+	return machineErrors, nil
+}
+
+func (c *Client) getMachineErrors(groupId uint, criteria Criteria) (map[string][]MachineError, error) {
+	machineErrors := make(map[string][]MachineError)
 	offset := 0
 	limit := 100
 	for {
-		request, err := http.NewPostRequestWithHeaders(url+"/rest/dashboard/error/search?groupid="+strconv.Itoa(int(groupId)),
+		meta, err := read[Meta[MachineError]](c, "POST", "/rest/dashboard/error/search?groupid="+strconv.Itoa(int(groupId)),
 			Body{
-				Count:  true,
-				Limit:  limit,
-				Offset: offset,
+				Count:    true,
+				Criteria: criteria,
+				Limit:    limit,
+				Offset:   offset,
 				Sort: map[string]any{
 					"timestamp.milliseconds": "desc",
 				},
 			},
-			map[string]string{
-				"Authorization": "Bearer " + token,
-				"API-Key":       apiKey,
-			},
 		)
 		if err != nil {
 			return nil, err
 		}
-		meta, err := http.Read[Meta[MachineError]](request, timeout, true)
-		if err != nil {
-			return nil, err
-		}
 		for _, machineError := range meta.Result {
 			serialNumber := machineError.Origin.SerialNumber
-			if _, exists := machineErrors[serialNumber]; !exists {
-				machineErrors[serialNumber] = machineError
-			}
+			machineErrors[serialNumber] = append(machineErrors[serialNumber], machineError)
 		}
 		offset = offset + limit
 		if offset >= meta.Count {
@@ -172,19 +202,32 @@ func GetMachineErrors(url string, apiKey string, token string, groupId uint, tim
 	return machineErrors, nil
 }
 
-func GetHealthStatuses(url string, apiKey string, token string, groupId uint, timeout time.Duration) (map[string]HealthStatus, error) {
-	healthStatuses := make(map[string]HealthStatus)
-	request, err := http.NewPostRequestWithHeaders(url+"/rest/dashboard/healthkpi?groupid="+strconv.Itoa(int(groupId)),
-		nil,
-		map[string]string{
-			"Authorization": "Bearer " + token,
-			"API-Key":       apiKey,
-		},
-	)
-	if err != nil {
-		return nil, err
+// searchCriteria returns the criteria to send to CoffeeCloud. No criteria are sent anymore once
+// CoffeeCloud rejected them, the search results are then filtered by the client only.
+func (c *Client) searchCriteria(criteria Criteria) Criteria {
+	if c.session.criteriaRejected.Load() {
+		return Criteria{}
+	}
+	return criteria
+}
+
+// rejectsCriteria checks whether the search failed because CoffeeCloud does not support the sent
+// criteria. The search query format is not part of a published API description, so the client
+// falls back to unfiltered searches filtered locally in this case.
+func (c *Client) rejectsCriteria(err error, sent Criteria) bool {
+	var statusErr *StatusError
+	if sent.empty() || !errors.As(err, &statusErr) || statusErr.StatusCode != nethttp.StatusBadRequest {
+		return false
 	}
-	meta, err := http.Read[HealthMeta](request, timeout, true)
+	if !c.session.criteriaRejected.Swap(true) {
+		log.Warn("coffeecloud", "search criteria rejected by %s, filtering search results locally: %v", c.url, err)
+	}
+	return true
+}
+
+func (c *Client) GetHealthStatuses(groupId uint) (map[string]HealthStatus, error) {
+	healthStatuses := make(map[string]HealthStatus)
+	meta, err := read[HealthMeta](c, "POST", "/rest/dashboard/healthkpi?groupid="+strconv.Itoa(int(groupId)), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,76 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package coffeecloud
+
+import (
+	"sort"
+)
+
+// FlattenGroups returns the groups including all nested child groups. Each group gets its
+// parent and depth in the hierarchy set. Parents are listed before their children, so the
+// hierarchy can be created top down.
+func FlattenGroups(groups []CoffeeGroup) []CoffeeGroup {
+	var flattened []CoffeeGroup
+	indexes := make(map[uint]int)
+	var collect func(groups []CoffeeGroup, parentID *uint)
+	collect = func(groups []CoffeeGroup, parentID *uint) {
+		for _, group := range groups {
+			if group.ParentID == nil && parentID != nil {
+				group.ParentID = parentID
+			}
+			children := group.Children
+			group.Children = nil
+			if index, exists := indexes[group.ID]; exists {
+				flattened[index] = group
+			} else {
+				indexes[group.ID] = len(flattened)
+				flattened = append(flattened, group)
+			}
+			id := group.ID
+			collect(children, &id)
+		}
+	}
+	collect(groups, nil)
+
+	parents := make(map[uint]uint)
+	for _, group := range flattened {
+		if group.ParentID != nil {
+			parents[group.ID] = *group.ParentID
+		}
+	}
+	for i := range flattened {
+		flattened[i].Depth = depth(flattened[i].ID, parents)
+	}
+	sort.SliceStable(flattened, func(i, j int) bool {
+		return flattened[i].Depth < flattened[j].Depth
+	})
+	return flattened
+}
+
+// depth counts the ancestors of the group. Cyclic parent references are cut off.
+func depth(id uint, parents map[uint]uint) int {
+	visited := map[uint]bool{id: true}
+	depth := 0
+	for {
+		parent, exists := parents[id]
+		if !exists || visited[parent] {
+			return depth
+		}
+		visited[parent] = true
+		id = parent
+		depth++
+	}
+}
@@ -16,9 +16,15 @@
 package coffeecloud
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"strings"
 	"time"
 
 	"github.com/eliona-smart-building-assistant/go-utils/http"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
 
 type Login struct {
@@ -31,18 +37,61 @@ type AuthToken struct {
 	IdToken string `json:"id_token"`
 }
 
-func GetAuthToken(url string, username string, password string, timeout time.Duration) (*string, error) {
-	request, err := http.NewPostRequest(url+"/rest/login", Login{
-		Username:   username,
-		Password:   password,
-		RememberMe: false,
-	})
+func (c *Client) getAuthToken() (*string, error) {
+	authToken, err := decode[AuthToken](c.do(func() (*nethttp.Request, error) {
+		return http.NewPostRequest(c.url+"/rest/login", Login{
+			Username:   c.username,
+			Password:   c.password,
+			RememberMe: false,
+		})
+	}))
 	if err != nil {
 		return nil, err
 	}
-	authToken, err := http.Read[AuthToken](request, timeout, true)
+	return &authToken.IdToken, nil
+}
+
+// expirySkew is subtracted from the token expiry to avoid using a token that expires during a request.
+const expirySkew = 30 * time.Second
+
+type cachedToken struct {
+	idToken   string
+	expiresAt *time.Time
+}
+
+func newCachedToken(idToken string) *cachedToken {
+	token := &cachedToken{idToken: idToken}
+	expiresAt, err := tokenExpiry(idToken)
 	if err != nil {
-		return nil, err
+		log.Debug("coffeecloud", "cannot decode token expiry, using token until rejected: %v", err)
+		return token
 	}
-	return &authToken.IdToken, nil
+	token.expiresAt = &expiresAt
+	return token
+}
+
+func (t *cachedToken) expired() bool {
+	return t.expiresAt != nil && time.Now().Add(expirySkew).After(*t.expiresAt)
+}
+
+// tokenExpiry decodes the "exp" claim from the payload of the JWT id token.
+func tokenExpiry(idToken string) (time.Time, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decoding token payload: %w", err)
+	}
+	var claims struct {
+		Exp *int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("unmarshalling token claims: %w", err)
+	}
+	if claims.Exp == nil {
+		return time.Time{}, fmt.Errorf("token has no expiry")
+	}
+	return time.Unix(*claims.Exp, 0), nil
 }
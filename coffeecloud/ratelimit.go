@@ -0,0 +1,107 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package coffeecloud
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// requestsPerGroup is the minimal number of requests needed to collect the machines,
+// errors and health states of one group.
+const requestsPerGroup = 3
+
+// rateLimiter spreads the requests of a client evenly, so that not more than the
+// configured number of requests per minute are sent.
+type rateLimiter struct {
+	mutex    sync.Mutex
+	interval time.Duration
+	next     time.Time
+	// overBudget is the budget last warned about, or empty if the requests fit the refresh interval.
+	overBudget budget
+}
+
+// budget is the number of groups collected with the rate limit within the refresh interval.
+type budget struct {
+	groups          int
+	interval        time.Duration
+	refreshInterval time.Duration
+}
+
+// SetRequestsPerMinute limits the requests sent by the client. A limit of zero or
+// less disables the limitation.
+func (c *Client) SetRequestsPerMinute(requestsPerMinute int32) {
+	c.limiter.mutex.Lock()
+	defer c.limiter.mutex.Unlock()
+	c.limiter.interval = 0
+	if requestsPerMinute > 0 {
+		c.limiter.interval = time.Minute / time.Duration(requestsPerMinute)
+	}
+}
+
+// WarnIfOverBudget logs a warning if the requests needed to collect the given number
+// of groups cannot be sent within the refresh interval. It logs only if this changed since
+// the last call, so it is not repeated in each cycle.
+func (c *Client) WarnIfOverBudget(groups int, refreshInterval time.Duration) {
+	requests, needed, over, changed := c.limiter.checkBudget(groups, refreshInterval)
+	if !changed {
+		return
+	}
+	if over {
+		log.Warn("coffeecloud", "collecting %d groups needs at least %d requests taking %v "+
+			"with the configured rate limit, which exceeds the refresh interval of %v",
+			groups, requests, needed, refreshInterval)
+	} else {
+		log.Info("coffeecloud", "collecting %d groups fits the refresh interval of %v again", groups, refreshInterval)
+	}
+}
+
+// checkBudget returns the requests needed to collect the groups, the time they take with the rate
+// limit, whether this exceeds the refresh interval and whether the budget changed since the last check.
+func (l *rateLimiter) checkBudget(groups int, refreshInterval time.Duration) (int, time.Duration, bool, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	requests := 2 + groups*requestsPerGroup
+	needed := time.Duration(requests) * l.interval
+	over := l.interval > 0 && refreshInterval > 0 && needed > refreshInterval
+	var current budget
+	if over {
+		current = budget{groups: groups, interval: l.interval, refreshInterval: refreshInterval}
+	}
+	changed := current != l.overBudget
+	l.overBudget = current
+	return requests, needed, over, changed
+}
+
+// wait blocks until the next request is allowed by the rate limit or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mutex.Lock()
+	if l.interval == 0 {
+		l.mutex.Unlock()
+		return nil
+	}
+	now := time.Now()
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next = slot.Add(l.interval)
+	l.mutex.Unlock()
+	return sleep(ctx, slot.Sub(now))
+}
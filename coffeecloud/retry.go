@@ -0,0 +1,122 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package coffeecloud
+
+import (
+	"errors"
+	"math/rand"
+	nethttp "net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	maxRetriesPerRequest = 5
+	maxRetriesPerCycle   = 20
+	initialBackoff       = time.Second
+	maxBackoff           = 30 * time.Second
+)
+
+// retryBudget limits the retries of a client within one collection cycle, so that a slow
+// or failing CoffeeCloud cannot delay the cycle beyond the refresh interval.
+type retryBudget struct {
+	mutex    sync.Mutex
+	used     int
+	deadline time.Time
+}
+
+// StartCycle resets the retry budget for a new collection cycle. Retries are only done
+// if they can be finished within the given interval. An interval of zero means no limit.
+func (c *Client) StartCycle(interval time.Duration) {
+	c.retries.mutex.Lock()
+	defer c.retries.mutex.Unlock()
+	c.retries.used = 0
+	c.retries.deadline = time.Time{}
+	if interval > 0 {
+		c.retries.deadline = time.Now().Add(interval)
+	}
+}
+
+// next decides if the failed attempt should be retried and how long to wait before.
+func (b *retryBudget) next(attempt int, err error) (time.Duration, bool) {
+	if attempt >= maxRetriesPerRequest {
+		return 0, false
+	}
+	wait, retryable := retryWait(attempt, err)
+	if !retryable {
+		return 0, false
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.used >= maxRetriesPerCycle {
+		return 0, false
+	}
+	if !b.deadline.IsZero() && time.Now().Add(wait).After(b.deadline) {
+		return 0, false
+	}
+	b.used++
+	return wait, true
+}
+
+// retryWait returns the time to wait before the next attempt. Too many requests responses
+// are retried as requested by the Retry-After header, server and network errors with an
+// exponential backoff.
+func retryWait(attempt int, err error) (time.Duration, bool) {
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		return backoff(attempt), true
+	}
+	switch {
+	case statusErr.StatusCode == nethttp.StatusTooManyRequests:
+		if wait, ok := parseRetryAfter(statusErr.RetryAfter); ok {
+			return wait, true
+		}
+		return backoff(attempt), true
+	case statusErr.StatusCode >= 500:
+		return backoff(attempt), true
+	default:
+		return 0, false
+	}
+}
+
+// backoff returns an exponential backoff with full jitter.
+func backoff(attempt int) time.Duration {
+	limit := initialBackoff << attempt
+	if limit > maxBackoff || limit <= 0 {
+		limit = maxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(limit))) + 1
+}
+
+// parseRetryAfter reads the Retry-After header given either in seconds or as HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := nethttp.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
@@ -0,0 +1,102 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"coffeecloud/appdb"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Cleanings summarizes the detected cleanings of a machine.
+type Cleanings struct {
+	LastCleanedAt *time.Time
+	Count         int64
+}
+
+// DetectCleaning compares the hours since the last cleaning with the value of the previous
+// cycle. If the value dropped, the machine was cleaned and the cleaning is stored. The time
+// of the cleaning is estimated from the current hours since cleaning.
+func DetectCleaning(ctx context.Context, configId int64, serialNumber string, hoursSinceCleaned int, now time.Time) (Cleanings, error) {
+	state, err := appdb.MachineStates(
+		appdb.MachineStateWhere.ConfigurationID.EQ(configId),
+		appdb.MachineStateWhere.SerialNumber.EQ(serialNumber),
+	).OneG(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return Cleanings{}, fmt.Errorf("fetching machine state: %v", err)
+	}
+
+	if state != nil && int32(hoursSinceCleaned) < state.HoursSinceCleaned {
+		cleaning := appdb.MachineCleaning{
+			ConfigurationID: configId,
+			SerialNumber:    serialNumber,
+			CleanedAt:       now.Add(-time.Duration(hoursSinceCleaned) * time.Hour),
+			HoursBefore:     state.HoursSinceCleaned,
+		}
+		if err := cleaning.InsertG(ctx, boil.Infer()); err != nil {
+			return Cleanings{}, fmt.Errorf("inserting machine cleaning: %v", err)
+		}
+	}
+
+	if state == nil {
+		state = &appdb.MachineState{
+			ConfigurationID: configId,
+			SerialNumber:    serialNumber,
+		}
+	}
+	state.HoursSinceCleaned = int32(hoursSinceCleaned)
+	if err := state.UpsertG(ctx, true, []string{
+		appdb.MachineStateColumns.ConfigurationID,
+		appdb.MachineStateColumns.SerialNumber,
+	}, boil.Whitelist(appdb.MachineStateColumns.HoursSinceCleaned), boil.Infer()); err != nil {
+		return Cleanings{}, fmt.Errorf("upserting machine state: %v", err)
+	}
+
+	return GetCleanings(ctx, configId, serialNumber)
+}
+
+// GetCleanings returns the time of the latest cleaning and the number of cleanings
+// detected for the machine.
+func GetCleanings(ctx context.Context, configId int64, serialNumber string) (Cleanings, error) {
+	var cleanings Cleanings
+	count, err := appdb.MachineCleanings(
+		appdb.MachineCleaningWhere.ConfigurationID.EQ(configId),
+		appdb.MachineCleaningWhere.SerialNumber.EQ(serialNumber),
+	).CountG(ctx)
+	if err != nil {
+		return cleanings, fmt.Errorf("counting machine cleanings: %v", err)
+	}
+	cleanings.Count = count
+	if count == 0 {
+		return cleanings, nil
+	}
+	latest, err := appdb.MachineCleanings(
+		appdb.MachineCleaningWhere.ConfigurationID.EQ(configId),
+		appdb.MachineCleaningWhere.SerialNumber.EQ(serialNumber),
+		qm.OrderBy(appdb.MachineCleaningColumns.CleanedAt+" desc"),
+	).OneG(ctx)
+	if err != nil {
+		return cleanings, fmt.Errorf("fetching latest machine cleaning: %v", err)
+	}
+	cleanings.LastCleanedAt = &latest.CleanedAt
+	return cleanings, nil
+}
@@ -18,10 +18,14 @@ package conf
 import (
 	"coffeecloud/apiserver"
 	"coffeecloud/appdb"
+	"coffeecloud/eliona"
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"time"
 
 	"github.com/eliona-smart-building-assistant/go-utils/common"
 	"github.com/volatiletech/null/v8"
@@ -29,11 +33,18 @@ import (
 )
 
 var ErrBadRequest = errors.New("bad request")
+var ErrNotFound = errors.New("not found")
+
+// Defaults of the configuration columns which cannot be null.
+const (
+	defaultRefreshInterval = 60
+	defaultRequestTimeout  = 120
+)
 
 func InsertConfig(ctx context.Context, config apiserver.Configuration) (apiserver.Configuration, error) {
-	dbConfig, err := dbConfigFromApiConfig(config)
+	dbConfig, err := dbConfigFromApiConfig(config, apiserver.Configuration{})
 	if err != nil {
-		return apiserver.Configuration{}, fmt.Errorf("creating DB config from API config: %v", err)
+		return apiserver.Configuration{}, fmt.Errorf("creating DB config from API config: %w", err)
 	}
 	if err := dbConfig.InsertG(ctx, boil.Infer()); err != nil {
 		return apiserver.Configuration{}, fmt.Errorf("inserting DB config: %v", err)
@@ -41,16 +52,55 @@ func InsertConfig(ctx context.Context, config apiserver.Configuration) (apiserve
 	return config, nil
 }
 
+// UpdateConfig replaces the stored configuration with the given one. Unset fields are reset to their
+// defaults. The active state is kept, as it is set by the app. It returns ErrNotFound if no
+// configuration with the ID exists.
+func UpdateConfig(ctx context.Context, config apiserver.Configuration) (apiserver.Configuration, error) {
+	storedConfig, err := GetConfig(ctx, common.Val(config.Id))
+	if err != nil {
+		return apiserver.Configuration{}, err
+	}
+	dbConfig, err := dbConfigFromApiConfig(config, *storedConfig)
+	if err != nil {
+		return apiserver.Configuration{}, fmt.Errorf("creating DB config from API config: %w", err)
+	}
+	if dbConfig.RefreshInterval == 0 {
+		dbConfig.RefreshInterval = defaultRefreshInterval
+	}
+	if dbConfig.RequestTimeout == 0 {
+		dbConfig.RequestTimeout = defaultRequestTimeout
+	}
+	count, err := dbConfig.UpdateG(ctx, boil.Blacklist(appdb.ConfigurationColumns.Active))
+	if err != nil {
+		return apiserver.Configuration{}, fmt.Errorf("updating DB config: %v", err)
+	}
+	if count == 0 {
+		return apiserver.Configuration{}, ErrNotFound
+	}
+	// A changed filter may include groups whose errors were never fetched
+	if !reflect.DeepEqual(storedConfig.AssetFilter, config.AssetFilter) {
+		if err := RefetchMachineErrors(ctx, dbConfig.ID); err != nil {
+			return apiserver.Configuration{}, err
+		}
+	}
+	updatedConfig, err := GetConfig(ctx, dbConfig.ID)
+	if err != nil {
+		return apiserver.Configuration{}, err
+	}
+	return *updatedConfig, nil
+}
+
+// GetConfig returns the configuration with the ID or ErrNotFound if it does not exist.
 func GetConfig(ctx context.Context, configID int64) (*apiserver.Configuration, error) {
 	dbConfig, err := appdb.Configurations(
 		appdb.ConfigurationWhere.ID.EQ(configID),
 	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
 	if err != nil {
 		return nil, fmt.Errorf("fetching config from database: %v", err)
 	}
-	if dbConfig == nil {
-		return nil, ErrBadRequest
-	}
 	apiConfig, err := apiConfigFromDbConfig(dbConfig)
 	if err != nil {
 		return nil, fmt.Errorf("creating API config from DB config: %v", err)
@@ -59,6 +109,29 @@ func GetConfig(ctx context.Context, configID int64) (*apiserver.Configuration, e
 }
 
 func DeleteConfig(ctx context.Context, configID int64) error {
+	if _, err := appdb.MachineCleanings(
+		appdb.MachineCleaningWhere.ConfigurationID.EQ(configID),
+	).DeleteAllG(ctx); err != nil {
+		return fmt.Errorf("deleting machine cleanings from database: %v", err)
+	}
+	if _, err := appdb.MachineMoves(
+		appdb.MachineMoveWhere.ConfigurationID.EQ(configID),
+	).DeleteAllG(ctx); err != nil {
+		return fmt.Errorf("deleting machine moves from database: %v", err)
+	}
+	if _, err := appdb.MachineStates(
+		appdb.MachineStateWhere.ConfigurationID.EQ(configID),
+	).DeleteAllG(ctx); err != nil {
+		return fmt.Errorf("deleting machine states from database: %v", err)
+	}
+	if _, err := appdb.MachineErrors(
+		appdb.MachineErrorWhere.ConfigurationID.EQ(configID),
+	).DeleteAllG(ctx); err != nil {
+		return fmt.Errorf("deleting machine errors from database: %v", err)
+	}
+	if err := DeleteMachineErrorCursor(ctx, configID); err != nil {
+		return err
+	}
 	if _, err := appdb.Assets(
 		appdb.AssetWhere.ConfigurationID.EQ(configID),
 	).DeleteAllG(ctx); err != nil {
@@ -79,18 +152,32 @@ func DeleteConfig(ctx context.Context, configID int64) error {
 	return nil
 }
 
-func dbConfigFromApiConfig(apiConfig apiserver.Configuration) (dbConfig appdb.Configuration, err error) {
-	dbConfig.Password = apiConfig.Password
+// dbConfigFromApiConfig converts the configuration for storing it. Encrypted credentials are only
+// accepted if they are the ones of the stored configuration.
+func dbConfigFromApiConfig(apiConfig apiserver.Configuration, storedConfig apiserver.Configuration) (dbConfig appdb.Configuration, err error) {
+	dbConfig.Password, err = encryptCredential(apiConfig.Password, storedConfig.Password)
+	if err != nil {
+		return appdb.Configuration{}, fmt.Errorf("encrypting password: %w", err)
+	}
 	dbConfig.Username = apiConfig.Username
 	dbConfig.URL = apiConfig.Url
-	dbConfig.APIKey = apiConfig.ApiKey
+	dbConfig.APIKey, err = encryptCredential(apiConfig.ApiKey, storedConfig.ApiKey)
+	if err != nil {
+		return appdb.Configuration{}, fmt.Errorf("encrypting API key: %w", err)
+	}
 
 	dbConfig.ID = null.Int64FromPtr(apiConfig.Id).Int64
-	dbConfig.Enable = null.BoolFromPtr(apiConfig.Enable)
+	// An omitted or null enable disables the configuration like the column default
+	dbConfig.Enable = null.BoolFrom(common.Val(apiConfig.Enable))
 	dbConfig.RefreshInterval = apiConfig.RefreshInterval
 	if apiConfig.RequestTimeout != nil {
 		dbConfig.RequestTimeout = *apiConfig.RequestTimeout
 	}
+	dbConfig.RequestsPerMinute = null.Int32FromPtr(apiConfig.RequestsPerMinute)
+	dbConfig.ConcurrentGroups = null.Int32FromPtr(apiConfig.ConcurrentGroups)
+	dbConfig.RemovedAssetPolicy = null.StringFromPtr(apiConfig.RemovedAssetPolicy)
+	dbConfig.PreferElionaNames = null.BoolFromPtr(apiConfig.PreferElionaNames)
+	dbConfig.HeartbeatCycles = null.Int32FromPtr(apiConfig.HeartbeatCycles)
 	af, err := json.Marshal(apiConfig.AssetFilter)
 	if err != nil {
 		return appdb.Configuration{}, fmt.Errorf("marshalling assetFilter: %v", err)
@@ -114,6 +201,11 @@ func apiConfigFromDbConfig(dbConfig *appdb.Configuration) (apiConfig apiserver.C
 	apiConfig.Enable = dbConfig.Enable.Ptr()
 	apiConfig.RefreshInterval = dbConfig.RefreshInterval
 	apiConfig.RequestTimeout = &dbConfig.RequestTimeout
+	apiConfig.RequestsPerMinute = dbConfig.RequestsPerMinute.Ptr()
+	apiConfig.ConcurrentGroups = dbConfig.ConcurrentGroups.Ptr()
+	apiConfig.RemovedAssetPolicy = dbConfig.RemovedAssetPolicy.Ptr()
+	apiConfig.PreferElionaNames = dbConfig.PreferElionaNames.Ptr()
+	apiConfig.HeartbeatCycles = dbConfig.HeartbeatCycles.Ptr()
 	if dbConfig.AssetFilter.Valid {
 		var af [][]apiserver.FilterRule
 		if err := json.Unmarshal(dbConfig.AssetFilter.JSON, &af); err != nil {
@@ -162,7 +254,7 @@ func IsConfigActive(config apiserver.Configuration) bool {
 }
 
 func IsConfigEnabled(config apiserver.Configuration) bool {
-	return config.Enable == nil || *config.Enable
+	return config.Enable != nil && *config.Enable
 }
 
 func SetAllConfigsInactive(ctx context.Context) (int64, error) {
@@ -171,15 +263,76 @@ func SetAllConfigsInactive(ctx context.Context) (int64, error) {
 	})
 }
 
-func InsertAsset(ctx context.Context, configId int64, assetId int32, projectId string, uniqueIdentifier string) error {
+// AssetState is the state of an asset as last synced to Eliona.
+type AssetState struct {
+	Name        string
+	Description string
+	ParentId    *int32
+	// Location is only compared and stored if known.
+	Location *eliona.Location
+}
+
+func InsertAsset(ctx context.Context, configId int64, assetId int32, projectId string, uniqueIdentifier string, state AssetState) error {
 	var dbAsset appdb.Asset
 	dbAsset.ConfigurationID = configId
 	dbAsset.ProjectID = projectId
 	dbAsset.Identifier = uniqueIdentifier
 	dbAsset.AssetID = null.Int32From(assetId)
+	setAssetState(&dbAsset, state)
 	return dbAsset.InsertG(ctx, boil.Infer())
 }
 
+func GetAsset(ctx context.Context, configId int64, projectId string, uniqueIdentifier string) (*appdb.Asset, error) {
+	dbAssets, err := appdb.Assets(
+		appdb.AssetWhere.ConfigurationID.EQ(configId),
+		appdb.AssetWhere.ProjectID.EQ(projectId),
+		appdb.AssetWhere.Identifier.EQ(uniqueIdentifier),
+	).AllG(ctx)
+	if err != nil || len(dbAssets) == 0 {
+		return nil, err
+	}
+	return dbAssets[0], nil
+}
+
+// AssetChanged checks if the state differs from the one last synced to Eliona.
+func AssetChanged(dbAsset *appdb.Asset, state AssetState) bool {
+	return !dbAsset.Name.Valid || dbAsset.Name.String != state.Name ||
+		!dbAsset.Description.Valid || dbAsset.Description.String != state.Description ||
+		dbAsset.ParentAssetID != null.Int32FromPtr(state.ParentId) ||
+		assetLocationChanged(dbAsset, state.Location)
+}
+
+func assetLocationChanged(dbAsset *appdb.Asset, location *eliona.Location) bool {
+	if location == nil {
+		return false
+	}
+	return !dbAsset.Latitude.Valid || !dbAsset.Longitude.Valid ||
+		dbAsset.Latitude.Float64 != location.Latitude || dbAsset.Longitude.Float64 != location.Longitude
+}
+
+// UpdateAsset stores the state last synced to Eliona.
+func UpdateAsset(ctx context.Context, dbAsset *appdb.Asset, state AssetState) error {
+	setAssetState(dbAsset, state)
+	_, err := dbAsset.UpdateG(ctx, boil.Whitelist(
+		appdb.AssetColumns.Name,
+		appdb.AssetColumns.Description,
+		appdb.AssetColumns.ParentAssetID,
+		appdb.AssetColumns.Latitude,
+		appdb.AssetColumns.Longitude,
+	))
+	return err
+}
+
+func setAssetState(dbAsset *appdb.Asset, state AssetState) {
+	dbAsset.Name = null.StringFrom(state.Name)
+	dbAsset.Description = null.StringFrom(state.Description)
+	dbAsset.ParentAssetID = null.Int32FromPtr(state.ParentId)
+	if state.Location != nil {
+		dbAsset.Latitude = null.Float64From(state.Location.Latitude)
+		dbAsset.Longitude = null.Float64From(state.Location.Longitude)
+	}
+}
+
 func GetAssetId(ctx context.Context, configId int64, projectId string, uniqueIdentifier string) (*int32, error) {
 	dbAsset, err := appdb.Assets(
 		appdb.AssetWhere.ConfigurationID.EQ(configId),
@@ -191,3 +344,24 @@ func GetAssetId(ctx context.Context, configId int64, projectId string, uniqueIde
 	}
 	return common.Ptr(dbAsset[0].AssetID.Int32), nil
 }
+
+// GetAssets returns all assets of the configuration in the project.
+func GetAssets(ctx context.Context, configId int64, projectId string) ([]*appdb.Asset, error) {
+	return appdb.Assets(
+		appdb.AssetWhere.ConfigurationID.EQ(configId),
+		appdb.AssetWhere.ProjectID.EQ(projectId),
+	).AllG(ctx)
+}
+
+// SetAssetRemoved marks the asset as no longer present in CoffeeCloud or, if removedAt is nil,
+// as present again.
+func SetAssetRemoved(ctx context.Context, dbAsset *appdb.Asset, removedAt *time.Time) error {
+	dbAsset.RemovedAt = null.TimeFromPtr(removedAt)
+	_, err := dbAsset.UpdateG(ctx, boil.Whitelist(appdb.AssetColumns.RemovedAt))
+	return err
+}
+
+func DeleteAsset(ctx context.Context, dbAsset *appdb.Asset) error {
+	_, err := dbAsset.DeleteG(ctx)
+	return err
+}
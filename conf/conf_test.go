@@ -0,0 +1,34 @@
+package conf
+
+import (
+	"coffeecloud/apiserver"
+	"testing"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+func TestDbConfigFromApiConfigEnable(t *testing.T) {
+	tests := []struct {
+		name   string
+		enable *bool
+		want   bool
+	}{
+		{"omitted", nil, false},
+		{"disabled", common.Ptr(false), false},
+		{"enabled", common.Ptr(true), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbConfig, err := dbConfigFromApiConfig(apiserver.Configuration{Enable: tt.enable}, apiserver.Configuration{})
+			if err != nil {
+				t.Fatalf("dbConfigFromApiConfig() error = %v", err)
+			}
+			if !dbConfig.Enable.Valid || dbConfig.Enable.Bool != tt.want {
+				t.Errorf("dbConfigFromApiConfig() enable = %v, want %v", dbConfig.Enable, tt.want)
+			}
+			if got := IsConfigEnabled(apiserver.Configuration{Enable: tt.enable}); got != tt.want {
+				t.Errorf("IsConfigEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"coffeecloud/apiserver"
+	"coffeecloud/appdb"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// The credentials are encrypted with envelope encryption: each value is encrypted with an own
+// random data key, and the data key is encrypted with the credentials key. To change the
+// credentials key, only the data keys have to be encrypted again.
+
+// Environment variables to supply the credentials key, either base64 encoded or as a file
+// containing the base64 encoded key.
+const (
+	CredentialsKeyEnv     = "CREDENTIALS_KEY"
+	CredentialsKeyFileEnv = "CREDENTIALS_KEY_FILE"
+)
+
+// encryptedPrefix marks encrypted values. Values without prefix are stored in plain text.
+const encryptedPrefix = "enc:v1:"
+
+// credentialsKeySize is the size of the credentials key and the data keys (AES-256).
+const credentialsKeySize = 32
+
+var ErrNoCredentialsKey = errors.New("no credentials key configured")
+
+// ErrEncryptedCredential is returned for encrypted credentials which are not the stored ones, as
+// only ciphertext taken from the database is trusted.
+var ErrEncryptedCredential = errors.New("encrypted credentials are only accepted unchanged")
+
+var credentialsKey struct {
+	once sync.Once
+	key  []byte
+	err  error
+}
+
+// LoadCredentialsKey returns the credentials key from the environment. It returns nil if
+// no key is configured.
+func LoadCredentialsKey() ([]byte, error) {
+	credentialsKey.once.Do(func() {
+		credentialsKey.key, credentialsKey.err = ReadCredentialsKey(os.Getenv(CredentialsKeyEnv), os.Getenv(CredentialsKeyFileEnv))
+	})
+	return credentialsKey.key, credentialsKey.err
+}
+
+// ReadCredentialsKey decodes the base64 encoded key, or reads it from the key file if no key is
+// given. It returns nil if neither is given.
+func ReadCredentialsKey(encodedKey string, keyFile string) ([]byte, error) {
+	if encodedKey == "" && keyFile != "" {
+		content, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading credentials key file: %v", err)
+		}
+		encodedKey = string(content)
+	}
+	encodedKey = strings.TrimSpace(encodedKey)
+	if encodedKey == "" {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("decoding credentials key: %v", err)
+	}
+	if len(key) != credentialsKeySize {
+		return nil, fmt.Errorf("credentials key has %d bytes instead of %d", len(key), credentialsKeySize)
+	}
+	return key, nil
+}
+
+// IsEncrypted reports whether the value is stored encrypted.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix)
+}
+
+// Credentials returns the decrypted password and API key of the configuration. It is meant for
+// the collection only, everywhere else the credentials are passed encrypted.
+func Credentials(config apiserver.Configuration) (password string, apiKey string, err error) {
+	key, err := LoadCredentialsKey()
+	if err != nil {
+		return "", "", err
+	}
+	if password, err = decryptSecret(key, config.Password); err != nil {
+		return "", "", fmt.Errorf("decrypting password: %w", err)
+	}
+	if apiKey, err = decryptSecret(key, config.ApiKey); err != nil {
+		return "", "", fmt.Errorf("decrypting API key: %w", err)
+	}
+	return password, apiKey, nil
+}
+
+// encryptCredential encrypts the value for storing it in the database. An encrypted value is kept
+// only if it is the stored one, so that configurations read from the database can be stored again.
+// Other encrypted values are rejected with ErrEncryptedCredential. Without credentials key, the
+// value is stored in plain text.
+func encryptCredential(value string, stored string) (string, error) {
+	if IsEncrypted(value) {
+		if value != stored {
+			return "", ErrEncryptedCredential
+		}
+		return value, nil
+	}
+	key, err := LoadCredentialsKey()
+	if err != nil {
+		return "", err
+	}
+	if key == nil {
+		return value, nil
+	}
+	return encryptSecret(key, value)
+}
+
+// ReencryptCredentials encrypts the credentials of all configurations with the new key. Values
+// encrypted with the old key get new encrypted data keys, values stored in plain text are encrypted.
+// It returns the number of configurations changed.
+func ReencryptCredentials(ctx context.Context, oldKey []byte, newKey []byte) (int, error) {
+	if newKey == nil {
+		return 0, ErrNoCredentialsKey
+	}
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	dbConfigs, err := appdb.Configurations().All(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("fetching configs from database: %v", err)
+	}
+	for _, dbConfig := range dbConfigs {
+		if dbConfig.Password, err = reencryptSecret(oldKey, newKey, dbConfig.Password); err != nil {
+			return 0, fmt.Errorf("re-encrypting password of config %d: %w", dbConfig.ID, err)
+		}
+		if dbConfig.APIKey, err = reencryptSecret(oldKey, newKey, dbConfig.APIKey); err != nil {
+			return 0, fmt.Errorf("re-encrypting API key of config %d: %w", dbConfig.ID, err)
+		}
+		if _, err := dbConfig.Update(ctx, tx, boil.Whitelist(appdb.ConfigurationColumns.Password, appdb.ConfigurationColumns.APIKey)); err != nil {
+			return 0, fmt.Errorf("updating config %d: %v", dbConfig.ID, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("committing transaction: %v", err)
+	}
+	return len(dbConfigs), nil
+}
+
+func encryptSecret(key []byte, plaintext string) (string, error) {
+	dataKey := make([]byte, credentialsKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", fmt.Errorf("generating data key: %v", err)
+	}
+	ciphertext, err := seal(dataKey, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	wrappedKey, err := seal(key, dataKey)
+	if err != nil {
+		return "", err
+	}
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(wrappedKey) + ":" + base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+func decryptSecret(key []byte, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	wrappedKey, ciphertext, err := splitSecret(value)
+	if err != nil {
+		return "", err
+	}
+	if key == nil {
+		return "", ErrNoCredentialsKey
+	}
+	dataKey, err := open(key, wrappedKey)
+	if err != nil {
+		return "", fmt.Errorf("decrypting data key: %w", err)
+	}
+	plaintext, err := open(dataKey, ciphertext)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func reencryptSecret(oldKey []byte, newKey []byte, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return encryptSecret(newKey, value)
+	}
+	wrappedKey, ciphertext, err := splitSecret(value)
+	if err != nil {
+		return "", err
+	}
+	if oldKey == nil {
+		return "", ErrNoCredentialsKey
+	}
+	dataKey, err := open(oldKey, wrappedKey)
+	if err != nil {
+		return "", fmt.Errorf("decrypting data key: %w", err)
+	}
+	wrappedKey, err = seal(newKey, dataKey)
+	if err != nil {
+		return "", err
+	}
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(wrappedKey) + ":" + base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+func splitSecret(value string) (wrappedKey []byte, ciphertext []byte, err error) {
+	parts := strings.Split(strings.TrimPrefix(value, encryptedPrefix), ":")
+	if len(parts) != 2 {
+		return nil, nil, fmt.Errorf("malformed encrypted value")
+	}
+	if wrappedKey, err = base64.StdEncoding.DecodeString(parts[0]); err != nil {
+		return nil, nil, fmt.Errorf("decoding data key: %v", err)
+	}
+	if ciphertext, err = base64.StdEncoding.DecodeString(parts[1]); err != nil {
+		return nil, nil, fmt.Errorf("decoding ciphertext: %v", err)
+	}
+	return wrappedKey, ciphertext, nil
+}
+
+// seal encrypts the plaintext with AES-GCM and prepends the nonce.
+func seal(key []byte, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generating nonce: %v", err)
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// open decrypts the ciphertext created by seal.
+func open(key []byte, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	plaintext, err := gcm.Open(nil, ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting: %v (wrong credentials key?)", err)
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %v", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("creating GCM: %v", err)
+	}
+	return gcm, nil
+}
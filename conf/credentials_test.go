@@ -0,0 +1,91 @@
+package conf
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSecretEncryption(t *testing.T) {
+	oldKey := bytes.Repeat([]byte{1}, credentialsKeySize)
+	newKey := bytes.Repeat([]byte{2}, credentialsKeySize)
+
+	encrypted, err := encryptSecret(oldKey, "secret")
+	if err != nil {
+		t.Fatalf("encryptSecret() error = %v", err)
+	}
+	if !IsEncrypted(encrypted) || bytes.Contains([]byte(encrypted), []byte("secret")) {
+		t.Fatalf("encryptSecret() = %q, not encrypted", encrypted)
+	}
+	if decrypted, err := decryptSecret(oldKey, encrypted); err != nil || decrypted != "secret" {
+		t.Errorf("decryptSecret() = %q, %v, want secret", decrypted, err)
+	}
+	if _, err := decryptSecret(newKey, encrypted); err == nil {
+		t.Errorf("decryptSecret() with wrong key succeeded")
+	}
+	if _, err := decryptSecret(nil, encrypted); err != ErrNoCredentialsKey {
+		t.Errorf("decryptSecret() without key error = %v, want %v", err, ErrNoCredentialsKey)
+	}
+
+	reencrypted, err := reencryptSecret(oldKey, newKey, encrypted)
+	if err != nil {
+		t.Fatalf("reencryptSecret() error = %v", err)
+	}
+	if decrypted, err := decryptSecret(newKey, reencrypted); err != nil || decrypted != "secret" {
+		t.Errorf("decryptSecret() after re-encryption = %q, %v, want secret", decrypted, err)
+	}
+	if _, err := decryptSecret(oldKey, reencrypted); err == nil {
+		t.Errorf("decryptSecret() with old key succeeded after re-encryption")
+	}
+
+	plain, err := reencryptSecret(oldKey, newKey, "plain")
+	if err != nil {
+		t.Fatalf("reencryptSecret() of plain text error = %v", err)
+	}
+	if decrypted, err := decryptSecret(newKey, plain); err != nil || decrypted != "plain" {
+		t.Errorf("decryptSecret() of encrypted plain text = %q, %v, want plain", decrypted, err)
+	}
+	if decrypted, err := decryptSecret(nil, "plain"); err != nil || decrypted != "plain" {
+		t.Errorf("decryptSecret() of plain text = %q, %v, want plain", decrypted, err)
+	}
+}
+
+func TestReadCredentialsKey(t *testing.T) {
+	key := bytes.Repeat([]byte{3}, credentialsKeySize)
+	encoded := base64.StdEncoding.EncodeToString(key)
+	keyFile := filepath.Join(t.TempDir(), "key")
+	if err := os.WriteFile(keyFile, []byte(encoded+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := ReadCredentialsKey(encoded, ""); err != nil || !bytes.Equal(got, key) {
+		t.Errorf("ReadCredentialsKey() from variable = %v, %v", got, err)
+	}
+	if got, err := ReadCredentialsKey("", keyFile); err != nil || !bytes.Equal(got, key) {
+		t.Errorf("ReadCredentialsKey() from file = %v, %v", got, err)
+	}
+	if got, err := ReadCredentialsKey("", ""); err != nil || got != nil {
+		t.Errorf("ReadCredentialsKey() without key = %v, %v, want nil", got, err)
+	}
+	if _, err := ReadCredentialsKey(base64.StdEncoding.EncodeToString([]byte("short")), ""); err == nil {
+		t.Errorf("ReadCredentialsKey() accepted short key")
+	}
+}
+
+func TestEncryptCredential(t *testing.T) {
+	stored, err := encryptSecret(bytes.Repeat([]byte{4}, credentialsKeySize), "secret")
+	if err != nil {
+		t.Fatalf("encryptSecret() error = %v", err)
+	}
+	if got, err := encryptCredential(stored, stored); err != nil || got != stored {
+		t.Errorf("encryptCredential() of stored value = %q, %v, want it unchanged", got, err)
+	}
+	if _, err := encryptCredential(stored, ""); err != ErrEncryptedCredential {
+		t.Errorf("encryptCredential() of new ciphertext error = %v, want %v", err, ErrEncryptedCredential)
+	}
+	if _, err := encryptCredential(encryptedPrefix+"forged", stored); err != ErrEncryptedCredential {
+		t.Errorf("encryptCredential() of other ciphertext error = %v, want %v", err, ErrEncryptedCredential)
+	}
+}
@@ -0,0 +1,182 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"coffeecloud/appdb"
+	"coffeecloud/eliona"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// MachineErrorCursor tells which errors of a configuration are fetched in the next cycle and which
+// of them raise alarms.
+type MachineErrorCursor struct {
+	// FetchFrom is the time the errors are fetched from, or nil to fetch the whole history.
+	FetchFrom *time.Time
+	// AlarmsFrom is the time of the first error raising an alarm, or nil to raise no alarms.
+	AlarmsFrom *time.Time
+}
+
+// GetMachineErrorCursor returns the cursor of the configuration. Before the errors were completely
+// fetched once, the whole history is fetched without raising alarms. After a refetch was requested,
+// the whole history is fetched, but only errors after the last cycle raise alarms.
+func GetMachineErrorCursor(ctx context.Context, configId int64) (MachineErrorCursor, error) {
+	dbCursor, err := appdb.FindMachineErrorCursorG(ctx, configId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return MachineErrorCursor{}, nil
+	}
+	if err != nil {
+		return MachineErrorCursor{}, fmt.Errorf("fetching machine error cursor: %v", err)
+	}
+	cursor := MachineErrorCursor{
+		FetchFrom:  &dbCursor.FetchedUntil,
+		AlarmsFrom: &dbCursor.FetchedUntil,
+	}
+	if dbCursor.Refetch {
+		cursor.FetchFrom = nil
+	}
+	return cursor, nil
+}
+
+// SetMachineErrorCursor stores the time up to which the errors of all groups of the configuration
+// were fetched and sent. A requested refetch is done then.
+func SetMachineErrorCursor(ctx context.Context, configId int64, fetchedUntil time.Time) error {
+	cursor := appdb.MachineErrorCursor{
+		ConfigurationID: configId,
+		FetchedUntil:    fetchedUntil,
+		Refetch:         false,
+	}
+	if err := cursor.UpsertG(ctx, true, []string{
+		appdb.MachineErrorCursorColumns.ConfigurationID,
+	}, boil.Whitelist(
+		appdb.MachineErrorCursorColumns.FetchedUntil,
+		appdb.MachineErrorCursorColumns.Refetch,
+	), boil.Infer()); err != nil {
+		return fmt.Errorf("upserting machine error cursor: %v", err)
+	}
+	return nil
+}
+
+// RefetchMachineErrors lets the next cycle fetch the whole error history again, e.g. because the
+// filter of the configuration changed and groups not fetched before are included now. Only errors
+// after the last cycle raise alarms.
+func RefetchMachineErrors(ctx context.Context, configId int64) error {
+	if _, err := appdb.MachineErrorCursors(
+		appdb.MachineErrorCursorWhere.ConfigurationID.EQ(configId),
+	).UpdateAllG(ctx, appdb.M{appdb.MachineErrorCursorColumns.Refetch: true}); err != nil {
+		return fmt.Errorf("updating machine error cursor: %v", err)
+	}
+	return nil
+}
+
+// DeleteMachineErrorCursor deletes the cursor of the configuration.
+func DeleteMachineErrorCursor(ctx context.Context, configId int64) error {
+	if _, err := appdb.MachineErrorCursors(
+		appdb.MachineErrorCursorWhere.ConfigurationID.EQ(configId),
+	).DeleteAllG(ctx); err != nil {
+		return fmt.Errorf("deleting machine error cursor: %v", err)
+	}
+	return nil
+}
+
+// GetLatestMachineErrors returns the latest error stored for each of the machines.
+func GetLatestMachineErrors(ctx context.Context, configId int64, serialNumbers []string) (map[string]eliona.MachineError, error) {
+	latestErrors := make(map[string]eliona.MachineError)
+	if len(serialNumbers) == 0 {
+		return latestErrors, nil
+	}
+	dbErrors, err := appdb.MachineErrors(
+		qm.Select("distinct on ("+appdb.MachineErrorColumns.SerialNumber+") *"),
+		appdb.MachineErrorWhere.ConfigurationID.EQ(configId),
+		appdb.MachineErrorWhere.SerialNumber.IN(serialNumbers),
+		qm.OrderBy(appdb.MachineErrorColumns.SerialNumber+", "+appdb.MachineErrorColumns.OccurredAt+" desc"),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching latest machine errors: %v", err)
+	}
+	for _, dbError := range dbErrors {
+		latestErrors[dbError.SerialNumber] = machineErrorFromDbMachineError(dbError)
+	}
+	return latestErrors, nil
+}
+
+// NewMachineErrors returns the errors which are not stored for the project yet.
+func NewMachineErrors(ctx context.Context, configId int64, projectId string, machineErrors []eliona.MachineError) ([]eliona.MachineError, error) {
+	var newErrors []eliona.MachineError
+	for _, machineError := range machineErrors {
+		exists, err := appdb.MachineErrors(
+			appdb.MachineErrorWhere.ConfigurationID.EQ(configId),
+			appdb.MachineErrorWhere.ProjectID.EQ(projectId),
+			appdb.MachineErrorWhere.SerialNumber.EQ(machineError.SerialNumber),
+			appdb.MachineErrorWhere.OccurredAt.EQ(machineError.Timestamp),
+			appdb.MachineErrorWhere.ErrorCode.EQ(int32(machineError.ErrorCode)),
+		).ExistsG(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("checking machine error: %v", err)
+		}
+		if !exists {
+			newErrors = append(newErrors, machineError)
+		}
+	}
+	return newErrors, nil
+}
+
+// InsertMachineErrors stores the errors sent to the project. Errors already stored are skipped. Store
+// the errors only after they were sent to Eliona, so errors failed to send are sent again in the
+// next cycle.
+func InsertMachineErrors(ctx context.Context, configId int64, projectId string, machineErrors []eliona.MachineError) error {
+	for _, machineError := range machineErrors {
+		dbError := appdb.MachineError{
+			ConfigurationID: configId,
+			ProjectID:       projectId,
+			GroupID:         machineError.GroupID,
+			SerialNumber:    machineError.SerialNumber,
+			ErrorCode:       int32(machineError.ErrorCode),
+			Error:           machineError.ErrorText,
+			ErrorShort:      machineError.ErrorDescription,
+			OccurredAt:      machineError.Timestamp,
+		}
+		err := dbError.UpsertG(ctx, false, []string{
+			appdb.MachineErrorColumns.ConfigurationID,
+			appdb.MachineErrorColumns.ProjectID,
+			appdb.MachineErrorColumns.SerialNumber,
+			appdb.MachineErrorColumns.OccurredAt,
+			appdb.MachineErrorColumns.ErrorCode,
+		}, boil.None(), boil.Infer())
+		if err != nil {
+			return fmt.Errorf("inserting machine error: %v", err)
+		}
+	}
+	return nil
+}
+
+func machineErrorFromDbMachineError(dbError *appdb.MachineError) eliona.MachineError {
+	return eliona.MachineError{
+		GroupID:          dbError.GroupID,
+		SerialNumber:     dbError.SerialNumber,
+		ErrorCode:        int(dbError.ErrorCode),
+		ErrorText:        dbError.Error,
+		ErrorDescription: dbError.ErrorShort,
+		Timestamp:        dbError.OccurredAt,
+	}
+}
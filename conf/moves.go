@@ -0,0 +1,89 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"coffeecloud/appdb"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// DetectMove compares the group of the machine with the group of the previous cycle. If the group
+// changed and leftGroup confirms the machine has left the previous group, the move is recorded in
+// the history. Otherwise, e.g. if the machine is only placed in another group because the filter
+// changed, just the group is updated. It returns the previous group if the machine moved.
+func DetectMove(ctx context.Context, configId int64, serialNumber string, machineId string, groupId string, leftGroup func(groupId string) bool, now time.Time) (*string, error) {
+	state, err := appdb.MachineStates(
+		appdb.MachineStateWhere.ConfigurationID.EQ(configId),
+		appdb.MachineStateWhere.SerialNumber.EQ(serialNumber),
+	).OneG(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("fetching machine state: %v", err)
+	}
+
+	var fromGroupId *string
+	if state != nil && state.GroupID.Valid && state.GroupID.String != groupId && leftGroup(state.GroupID.String) {
+		fromGroupId = &state.GroupID.String
+		move := appdb.MachineMove{
+			ConfigurationID: configId,
+			SerialNumber:    serialNumber,
+			MachineID:       machineId,
+			FromGroupID:     state.GroupID.String,
+			ToGroupID:       groupId,
+			MovedAt:         now,
+		}
+		if err := move.InsertG(ctx, boil.Infer()); err != nil {
+			return nil, fmt.Errorf("inserting machine move: %v", err)
+		}
+	}
+
+	if state == nil {
+		state = &appdb.MachineState{
+			ConfigurationID: configId,
+			SerialNumber:    serialNumber,
+		}
+	}
+	state.GroupID = null.StringFrom(groupId)
+	if err := state.UpsertG(ctx, true, []string{
+		appdb.MachineStateColumns.ConfigurationID,
+		appdb.MachineStateColumns.SerialNumber,
+	}, boil.Whitelist(appdb.MachineStateColumns.GroupID), boil.Infer()); err != nil {
+		return nil, fmt.Errorf("upserting machine state: %v", err)
+	}
+	return fromGroupId, nil
+}
+
+// GetMachineGroupIds returns the group each machine was in during the previous cycle, keyed by serial number.
+func GetMachineGroupIds(ctx context.Context, configId int64) (map[string]string, error) {
+	states, err := appdb.MachineStates(
+		appdb.MachineStateWhere.ConfigurationID.EQ(configId),
+		appdb.MachineStateWhere.GroupID.IsNotNull(),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching machine states: %v", err)
+	}
+	groupIds := make(map[string]string)
+	for _, state := range states {
+		groupIds[state.SerialNumber] = state.GroupID.String
+	}
+	return groupIds, nil
+}
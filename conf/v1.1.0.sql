@@ -0,0 +1,85 @@
+--  This file is part of the eliona project.
+--  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+--  ______ _ _
+-- |  ____| (_)
+-- | |__  | |_  ___  _ __   __ _
+-- |  __| | | |/ _ \| '_ \ / _` |
+-- | |____| | | (_) | | | | (_| |
+-- |______|_|_|\___/|_| |_|\__,_|
+--
+--  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+--  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+--  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+--  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+--  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+-- Patch for installations initialized by previous versions. Also completes the schema of new
+-- installations created by init.sql, so all statements must be repeatable.
+
+alter table coffeecloud.configuration add column if not exists requests_per_minute integer;
+alter table coffeecloud.configuration add column if not exists concurrent_groups integer;
+alter table coffeecloud.configuration add column if not exists removed_asset_policy text;
+alter table coffeecloud.configuration add column if not exists prefer_eliona_names boolean default false;
+alter table coffeecloud.configuration add column if not exists heartbeat_cycles integer;
+
+alter table coffeecloud.asset
+	add column if not exists latitude  double precision,
+	add column if not exists longitude double precision;
+
+alter table coffeecloud.asset add column if not exists removed_at timestamp with time zone;
+
+alter table coffeecloud.asset
+	add column if not exists name            text,
+	add column if not exists description     text,
+	add column if not exists parent_asset_id integer;
+
+create table if not exists coffeecloud.machine_error
+(
+	id               bigserial primary key,
+	configuration_id bigint    not null references coffeecloud.configuration(id),
+	project_id       text      not null,
+	group_id         text      not null,
+	serial_number    text      not null,
+	error_code       integer   not null,
+	error            text      not null,
+	error_short      text      not null,
+	occurred_at      timestamp with time zone not null,
+	unique (configuration_id, project_id, serial_number, occurred_at, error_code)
+);
+
+create table if not exists coffeecloud.machine_state
+(
+	id                  bigserial primary key,
+	configuration_id    bigint    not null references coffeecloud.configuration(id),
+	serial_number       text      not null,
+	hours_since_cleaned integer   not null,
+	group_id            text,
+	unique (configuration_id, serial_number)
+);
+
+create table if not exists coffeecloud.machine_move
+(
+	id               bigserial primary key,
+	configuration_id bigint    not null references coffeecloud.configuration(id),
+	serial_number    text      not null,
+	machine_id       text      not null,
+	from_group_id    text      not null,
+	to_group_id      text      not null,
+	moved_at         timestamp with time zone not null
+);
+
+create table if not exists coffeecloud.machine_cleaning
+(
+	id                  bigserial primary key,
+	configuration_id    bigint    not null references coffeecloud.configuration(id),
+	serial_number       text      not null,
+	cleaned_at          timestamp with time zone not null,
+	hours_before        integer   not null
+);
+
+create table if not exists coffeecloud.machine_error_cursor
+(
+	configuration_id bigint    primary key references coffeecloud.configuration(id),
+	fetched_until    timestamp with time zone not null,
+	refetch          boolean   not null default false
+);
@@ -0,0 +1,100 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"fmt"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+// errorAlarmAttribute is the attribute of the machine asset the error alarms belong to.
+const errorAlarmAttribute = "error_code"
+
+// ErrorAlarmRules raises the alarms for machine errors. The alarm rules of all assets are fetched
+// once on the first alarm, so use a new instance for each cycle.
+type ErrorAlarmRules struct {
+	ruleIds map[int32]int32
+}
+
+// RaiseErrorAlarm raises an Eliona alarm for the machine error with the time it occurred.
+func (r *ErrorAlarmRules) RaiseErrorAlarm(assetId int32, machineError MachineError) error {
+	ruleId, err := r.ruleId(assetId)
+	if err != nil {
+		return err
+	}
+	alarm := api.NewAlarm(ruleId, alarmMessage(machineError.ErrorText))
+	alarm.AssetId = *api.NewNullableInt32(&assetId)
+	alarm.Subtype = *api.NewNullableString(common.Ptr(string(api.SUBTYPE_STATUS)))
+	alarm.Attribute = *api.NewNullableString(common.Ptr(errorAlarmAttribute))
+	alarm.Priority = *api.NewNullableInt32(common.Ptr(int32(api.ALARM_PRIORITY_MEDIUM)))
+	alarm.RequiresAcknowledge = *api.NewNullableBool(common.Ptr(true))
+	alarm.Value = *api.NewNullableFloat64(common.Ptr(float64(machineError.ErrorCode)))
+	alarm.Timestamp = *api.NewNullableTime(&machineError.Timestamp)
+	_, _, err = client.NewClient().AlarmsAPI.
+		PutAlarm(client.AuthenticationContext()).
+		Alarm(*alarm).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("putting error alarm for asset %d: %w", assetId, err)
+	}
+	return nil
+}
+
+// ruleId returns the ID of the alarm rule for the errors of the machine asset. The rule is created
+// if it does not exist yet. It has no limits, so alarms are only raised by RaiseErrorAlarm and not
+// by the latest error kept in the error attributes.
+func (r *ErrorAlarmRules) ruleId(assetId int32) (int32, error) {
+	if r.ruleIds == nil {
+		rules, _, err := client.NewClient().AlarmRulesAPI.
+			GetAlarmRules(client.AuthenticationContext()).
+			Execute()
+		if err != nil {
+			return 0, fmt.Errorf("getting alarm rules: %w", err)
+		}
+		r.ruleIds = make(map[int32]int32)
+		for _, rule := range rules {
+			if rule.Subtype == api.SUBTYPE_STATUS && rule.Attribute == errorAlarmAttribute && rule.Id.Get() != nil {
+				r.ruleIds[rule.AssetId] = rule.GetId()
+			}
+		}
+	}
+	if ruleId, exists := r.ruleIds[assetId]; exists {
+		return ruleId, nil
+	}
+	rule := api.NewAlarmRule(assetId, api.SUBTYPE_STATUS, errorAlarmAttribute, api.ALARM_PRIORITY_MEDIUM)
+	rule.RequiresAcknowledge = common.Ptr(true)
+	rule.Subject = *api.NewNullableString(common.Ptr("CoffeeCloud machine error"))
+	rule.Message = alarmMessage("The coffee machine reported an error")
+	created, _, err := client.NewClient().AlarmRulesAPI.
+		PostAlarmRule(client.AuthenticationContext()).
+		AlarmRule(*rule).
+		Execute()
+	if err != nil {
+		return 0, fmt.Errorf("creating alarm rule for asset %d: %w", assetId, err)
+	}
+	r.ruleIds[assetId] = created.GetId()
+	return created.GetId(), nil
+}
+
+func alarmMessage(text string) map[string]interface{} {
+	return map[string]interface{}{
+		"de": text,
+		"en": text,
+	}
+}
@@ -20,8 +20,11 @@ import (
 	"fmt"
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
 	"github.com/eliona-smart-building-assistant/go-eliona/asset"
-	"github.com/eliona-smart-building-assistant/go-eliona/utils"
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
 	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"net/http"
+	"regexp/syntax"
+	"time"
 )
 
 const CoffeeCloudMachineAssetType = "coffeecloud_machine"
@@ -29,66 +32,252 @@ const CoffeeCloudGroupAssetType = "coffeecloud_group"
 const CoffeeCloudRootAssetType = "coffeecloud_root"
 
 type MachineGroup struct {
-	GroupID   string `json:"groupId" eliona:"group_id,filterable"`
-	GroupName string `json:"groupName" eliona:"group_name,filterable"`
-	Machines  []Machine
+	GroupID       string `json:"groupId" eliona:"group_id,filterable"`
+	GroupName     string `json:"groupName" eliona:"group_name,filterable"`
+	ParentGroupID string `json:"parentGroupId,omitempty" eliona:"parent_group_id"`
+	Stale         bool   `json:"stale,omitempty" eliona:"stale" subtype:"status"`
+	Active        bool   `json:"active,omitempty" eliona:"active" subtype:"status"`
+	Machines      []Machine
 }
 
 type Machine struct {
-	MachineID    string `json:"machineId" eliona:"machine_id,filterable"`
+	MachineID    string `json:"machineId" eliona:"machine_id,filterable" subtype:"info"`
 	MachineName  string `json:"machineName" eliona:"machine_name,filterable"`
-	SerialNumber string `json:"serialNumber,omitempty" eliona:"serial_number,filterable"`
-	Firmware     int    `json:"firmware,omitempty" eliona:"firmware,filterable"`
-
-	CupCount          int    `json:"cupCount,omitempty" eliona:"cup_count" subtype:"input"`
-	EngineStatus      string `json:"engineStatus,omitempty" eliona:"engine_status,filterable" subtype:"status"`
-	HoursSinceCleaned int    `json:"hourSinceCleaned,omitempty" eliona:"hours_since_cleaned" subtype:"status"`
-	ErrorCode         int    `json:"errorCode,omitempty" eliona:"error_code,filterable" subtype:"status"`
-	ErrorText         string `json:"errorText,omitempty" eliona:"error,filterable" subtype:"status"`
-	ErrorDescription  string `json:"errorDescription,omitempty" eliona:"error_description" subtype:"status"`
+	SerialNumber string `json:"serialNumber,omitempty" eliona:"serial_number,filterable" subtype:"info"`
+	Firmware     int    `json:"firmware,omitempty" eliona:"firmware,filterable" subtype:"info"`
+	GroupName    string `json:"groupName,omitempty" eliona:"group_name,filterable" subtype:"info"`
+
+	CupCount          int        `json:"cupCount,omitempty" eliona:"cup_count,filterable" subtype:"input"`
+	EngineStatus      string     `json:"engineStatus,omitempty" eliona:"engine_status,filterable" subtype:"status"`
+	HealthReason      string     `json:"healthReason,omitempty" eliona:"health_reason,filterable" subtype:"status"`
+	HealthCause       string     `json:"healthCause,omitempty" eliona:"health_cause,filterable" subtype:"status"`
+	HoursSinceCleaned int        `json:"hourSinceCleaned,omitempty" eliona:"hours_since_cleaned,filterable" subtype:"status"`
+	LastCleanedAt     *time.Time `json:"lastCleanedAt,omitempty" eliona:"last_cleaned_at" subtype:"status"`
+	CleaningsCount    int64      `json:"cleaningsCount,omitempty" eliona:"cleanings_count" subtype:"status"`
+	ErrorCode         int        `json:"errorCode,omitempty" eliona:"error_code,filterable" subtype:"status"`
+	ErrorText         string     `json:"errorText,omitempty" eliona:"error,filterable" subtype:"status"`
+	ErrorDescription  string     `json:"errorDescription,omitempty" eliona:"error_description" subtype:"status"`
+	ErrorOccurredAt   *time.Time `json:"errorOccurredAt,omitempty"`
+	Stale             bool       `json:"stale,omitempty" eliona:"stale" subtype:"status"`
+	Active            bool       `json:"active,omitempty" eliona:"active" subtype:"status"`
+
+	Location *Location      `json:"location,omitempty"`
+	Errors   []MachineError `json:"errors,omitempty"`
+}
+
+// MachineError is a single error reported by a machine.
+type MachineError struct {
+	GroupID          string    `json:"groupId"`
+	SerialNumber     string    `json:"serialNumber"`
+	ErrorCode        int       `json:"errorCode"`
+	ErrorText        string    `json:"errorText"`
+	ErrorDescription string    `json:"errorDescription"`
+	Timestamp        time.Time `json:"timestamp"`
 }
 
-func UpsertAsset(projectId string, uniqueIdentifier string, parentId *int32, assetType string, name string) (*int32, error) {
-	assetId, err := asset.UpsertAsset(api.Asset{
+// Location is the GPS position of an asset.
+type Location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+func UpsertAsset(projectId string, uniqueIdentifier string, parentId *int32, assetType string, name string, location *Location) (*int32, error) {
+	a := api.Asset{
 		ProjectId:               projectId,
 		GlobalAssetIdentifier:   uniqueIdentifier,
 		Name:                    *api.NewNullableString(common.Ptr(name)),
 		AssetType:               assetType,
-		Description:             *api.NewNullableString(common.Ptr(fmt.Sprintf("%s (%v)", name, uniqueIdentifier))),
+		Description:             *api.NewNullableString(common.Ptr(AssetDescription(name, uniqueIdentifier))),
 		ParentLocationalAssetId: *api.NewNullableInt32(parentId),
 		DeviceIds: []string{
 			uniqueIdentifier,
 		},
-	})
+	}
+	if location != nil {
+		a.Latitude = *api.NewNullableFloat64(common.Ptr(location.Latitude))
+		a.Longitude = *api.NewNullableFloat64(common.Ptr(location.Longitude))
+	}
+	assetId, err := asset.UpsertAsset(a)
 	if err != nil {
 		return nil, err
 	}
 	return assetId, nil
 }
 
+// AssetDescription returns the description set for assets created by the app.
+func AssetDescription(name string, uniqueIdentifier string) string {
+	return fmt.Sprintf("%s (%v)", name, uniqueIdentifier)
+}
+
+// GetAssetName returns the name of the asset as currently set in Eliona.
+func GetAssetName(assetId int32) (string, error) {
+	a, _, err := client.NewClient().AssetsAPI.
+		GetAssetById(client.AuthenticationContext(), assetId).
+		Execute()
+	if err != nil {
+		return "", fmt.Errorf("getting asset %d: %w", assetId, err)
+	}
+	return a.GetName(), nil
+}
+
+// MoveAsset places the asset below the given parent asset.
+func MoveAsset(assetId int32, parentId int32) error {
+	a, _, err := client.NewClient().AssetsAPI.
+		GetAssetById(client.AuthenticationContext(), assetId).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("getting asset %d: %w", assetId, err)
+	}
+	a.ParentLocationalAssetId = *api.NewNullableInt32(&parentId)
+	if _, err := asset.UpsertAsset(*a); err != nil {
+		return fmt.Errorf("moving asset %d: %w", assetId, err)
+	}
+	return nil
+}
+
+// DeleteAsset deletes the asset in Eliona. Assets already deleted are ignored.
+func DeleteAsset(assetId int32) error {
+	response, err := client.NewClient().AssetsAPI.
+		DeleteAssetById(client.AuthenticationContext(), assetId).
+		Execute()
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("deleting asset %d: %w", assetId, err)
+	}
+	return nil
+}
+
 func AdheresToFilter(input interface{}, filter [][]apiserver.FilterRule) (bool, error) {
-	f := apiFilterToCommonFilter(filter)
-	fp, err := utils.StructToMap(input)
+	properties, err := filterProperties(input)
 	if err != nil {
-		return false, fmt.Errorf("converting strict to map: %v", err)
+		return false, fmt.Errorf("converting struct to map: %v", err)
 	}
-	adheres, err := common.Filter(f, fp)
+	adheres, _, err := ExplainFilter(filter, properties)
 	if err != nil {
 		return false, err
 	}
 	return adheres, nil
 }
 
-func apiFilterToCommonFilter(input [][]apiserver.FilterRule) [][]common.FilterRule {
-	result := make([][]common.FilterRule, len(input))
-	for i := 0; i < len(input); i++ {
-		result[i] = make([]common.FilterRule, len(input[i]))
-		for j := 0; j < len(input[i]); j++ {
-			result[i][j] = common.FilterRule{
-				Parameter: input[i][j].Parameter,
-				Regex:     input[i][j].Regex,
+// SerialNumbersFromFilter returns the serial numbers a machine must have to adhere to the filter.
+// It returns nil, if the filter allows machines which cannot be selected by serial number, e.g.
+// because a rule set has no rule on the serial number with literal values.
+func SerialNumbersFromFilter(filter [][]apiserver.FilterRule) []string {
+	if len(filter) == 0 {
+		return nil
+	}
+	var serialNumbers []string
+	for _, conjunction := range filter {
+		var literals []string
+		for _, rule := range conjunction {
+			if rule.Parameter == "serial_number" && literals == nil {
+				literals = literalValues(rule)
+			}
+		}
+		if literals == nil {
+			return nil
+		}
+		serialNumbers = append(serialNumbers, literals...)
+	}
+	if len(serialNumbers) == 0 {
+		return nil
+	}
+	return serialNumbers
+}
+
+// literalValues returns the values a rule matches exclusively, or nil if the rule matches
+// other values as well.
+func literalValues(rule apiserver.FilterRule) []string {
+	switch ruleOperator(rule) {
+	case FilterOperatorRegex:
+		return literalAlternatives(rule.Regex)
+	case FilterOperatorEq:
+		return []string{rule.Value}
+	case FilterOperatorIn:
+		if len(rule.Values) == 0 {
+			return nil
+		}
+		return rule.Values
+	default:
+		return nil
+	}
+}
+
+// literalAlternatives returns the values matched by regular expressions like ^value$, ^(value1|value2)$
+// or ^(?:value1|value2)$, or nil if the regular expression matches other values as well. Only
+// expressions anchored as a whole qualify, e.g. ^SN1|SN2$ also matches SN1X.
+func literalAlternatives(regex string) []string {
+	re, err := syntax.Parse(regex, syntax.Perl)
+	if err != nil || re.Op != syntax.OpConcat || len(re.Sub) < 3 {
+		return nil
+	}
+	if re.Sub[0].Op != syntax.OpBeginText || re.Sub[len(re.Sub)-1].Op != syntax.OpEndText {
+		return nil
+	}
+	literals, ok := literalStrings(&syntax.Regexp{Op: syntax.OpConcat, Sub: re.Sub[1 : len(re.Sub)-1]})
+	if !ok {
+		return nil
+	}
+	return literals
+}
+
+// maxLiterals limits the number of values derived from a regular expression.
+const maxLiterals = 1000
+
+// literalStrings returns all strings the parsed expression matches, if these are a small finite set
+// of case-sensitive literals. The parser factors out common prefixes of alternatives, so
+// concatenations of alternatives and character classes are expanded.
+func literalStrings(re *syntax.Regexp) ([]string, bool) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		if re.Flags&syntax.FoldCase != 0 {
+			return nil, false
+		}
+		return []string{string(re.Rune)}, true
+	case syntax.OpEmptyMatch:
+		return []string{""}, true
+	case syntax.OpCapture:
+		return literalStrings(re.Sub[0])
+	case syntax.OpCharClass:
+		var literals []string
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			if int(re.Rune[i+1]-re.Rune[i])+len(literals) >= maxLiterals {
+				return nil, false
+			}
+			for r := re.Rune[i]; r <= re.Rune[i+1]; r++ {
+				literals = append(literals, string(r))
+			}
+		}
+		return literals, len(literals) > 0
+	case syntax.OpAlternate:
+		var literals []string
+		for _, sub := range re.Sub {
+			subLiterals, ok := literalStrings(sub)
+			if !ok || len(literals)+len(subLiterals) > maxLiterals {
+				return nil, false
+			}
+			literals = append(literals, subLiterals...)
+		}
+		return literals, true
+	case syntax.OpConcat:
+		literals := []string{""}
+		for _, sub := range re.Sub {
+			subLiterals, ok := literalStrings(sub)
+			if !ok || len(literals)*len(subLiterals) > maxLiterals {
+				return nil, false
+			}
+			var combined []string
+			for _, prefix := range literals {
+				for _, suffix := range subLiterals {
+					combined = append(combined, prefix+suffix)
+				}
 			}
+			literals = combined
 		}
+		return literals, true
+	default:
+		return nil, false
 	}
-	return result
 }
@@ -0,0 +1,100 @@
+package eliona
+
+import (
+	"coffeecloud/apiserver"
+	"reflect"
+	"testing"
+)
+
+func TestSerialNumbersFromFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter [][]apiserver.FilterRule
+		want   []string
+	}{
+		{
+			name: "no filter",
+			want: nil,
+		},
+		{
+			name:   "single serial number",
+			filter: [][]apiserver.FilterRule{{{Parameter: "serial_number", Regex: "^SN1$"}}},
+			want:   []string{"SN1"},
+		},
+		{
+			name:   "alternatives with common prefix",
+			filter: [][]apiserver.FilterRule{{{Parameter: "serial_number", Regex: "^(SN1|SN2)$"}}},
+			want:   []string{"SN1", "SN2"},
+		},
+		{
+			name:   "non-capturing alternatives",
+			filter: [][]apiserver.FilterRule{{{Parameter: "serial_number", Regex: "^(?:SN12|AB3)$"}}},
+			want:   []string{"SN12", "AB3"},
+		},
+		{
+			name:   "alternatives anchored separately",
+			filter: [][]apiserver.FilterRule{{{Parameter: "serial_number", Regex: "^SN1|SN2$"}}},
+			want:   nil,
+		},
+		{
+			name:   "case insensitive",
+			filter: [][]apiserver.FilterRule{{{Parameter: "serial_number", Regex: "(?i)^SN1$"}}},
+			want:   nil,
+		},
+		{
+			name: "group rule set without serial number",
+			filter: [][]apiserver.FilterRule{
+				{{Parameter: "serial_number", Regex: "^SN1$"}},
+				{{Parameter: "group_name", Regex: "^brew.*$"}},
+			},
+			want: nil,
+		},
+		{
+			name: "group rule with serial number",
+			filter: [][]apiserver.FilterRule{
+				{{Parameter: "group_name", Regex: "^brew.*$"}, {Parameter: "serial_number", Regex: "^SN1$"}},
+			},
+			want: []string{"SN1"},
+		},
+		{
+			name: "machine rule set without serial number",
+			filter: [][]apiserver.FilterRule{
+				{{Parameter: "serial_number", Regex: "^SN1$"}},
+				{{Parameter: "machine_name", Regex: "^brew.*$"}},
+			},
+			want: nil,
+		},
+		{
+			name:   "serial number pattern",
+			filter: [][]apiserver.FilterRule{{{Parameter: "serial_number", Regex: "^SN.*$"}}},
+			want:   nil,
+		},
+		{
+			name:   "serial number equal",
+			filter: [][]apiserver.FilterRule{{{Parameter: "serial_number", Operator: "eq", Value: "SN1"}}},
+			want:   []string{"SN1"},
+		},
+		{
+			name:   "serial number in list",
+			filter: [][]apiserver.FilterRule{{{Parameter: "serial_number", Operator: "in", Values: []string{"SN1", "SN2"}}}},
+			want:   []string{"SN1", "SN2"},
+		},
+		{
+			name:   "serial number not in list",
+			filter: [][]apiserver.FilterRule{{{Parameter: "serial_number", Operator: "not_in", Values: []string{"SN1"}}}},
+			want:   nil,
+		},
+		{
+			name:   "serial number without anchors",
+			filter: [][]apiserver.FilterRule{{{Parameter: "serial_number", Regex: "SN1"}}},
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SerialNumbersFromFilter(tt.filter); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SerialNumbersFromFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
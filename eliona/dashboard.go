@@ -16,130 +16,283 @@
 package eliona
 
 import (
+	"fmt"
+	"strconv"
+
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
 	"github.com/eliona-smart-building-assistant/go-eliona/client"
 	"github.com/eliona-smart-building-assistant/go-utils/common"
 )
 
-func CoffeeCloudDashboard(projectId string) (api.Dashboard, error) {
-	dashboard := api.Dashboard{}
-	dashboard.Name = "CoffeeCloud"
-	dashboard.ProjectId = projectId
-	dashboard.Widgets = []api.Widget{}
+// DashboardTemplate is a dashboard the app can build from the machine and group assets of a project.
+type DashboardTemplate struct {
+	Name        string
+	Description string
+	build       func(projectId string, widgetType tilesWidgetType) ([]api.Widget, error)
+}
+
+// Build creates the dashboard for the project.
+func (t DashboardTemplate) Build(projectId string) (api.Dashboard, error) {
+	widgetType, err := getTilesWidgetType()
+	if err != nil {
+		return api.Dashboard{}, err
+	}
+	widgets, err := t.build(projectId, widgetType)
+	if err != nil {
+		return api.Dashboard{}, err
+	}
+	return api.Dashboard{
+		Name:      t.Name,
+		ProjectId: projectId,
+		Widgets:   widgets,
+	}, nil
+}
+
+var dashboardTemplates = []DashboardTemplate{
+	{
+		Name:        "CoffeeCloud",
+		Description: "Cups, hours since cleaning and current error of each machine.",
+		build:       machineWidgets,
+	},
+	{
+		Name:        "CoffeeCloud Fleet Overview",
+		Description: "Number of machines, cups and machines in error or unhealthy for the whole fleet and each group.",
+		build:       fleetOverviewWidgets,
+	},
+	{
+		Name:        "CoffeeCloud Maintenance",
+		Description: "Hours since cleaning, detected cleanings and health status of each machine, grouped by machine group.",
+		build:       maintenanceWidgets,
+	},
+	{
+		Name:        "CoffeeCloud Errors",
+		Description: "Machines in error for each group and the current error of each machine.",
+		build:       errorWidgets,
+	},
+}
+
+// DashboardTemplates returns all dashboard templates provided by the app.
+func DashboardTemplates() []DashboardTemplate {
+	return dashboardTemplates
+}
+
+// GetDashboardTemplate returns the dashboard template with the given name.
+func GetDashboardTemplate(name string) (DashboardTemplate, bool) {
+	for _, template := range dashboardTemplates {
+		if template.Name == name {
+			return template, true
+		}
+	}
+	return DashboardTemplate{}, false
+}
+
+// tile is a single value shown by a tiles widget.
+type tile struct {
+	attribute    string
+	subtype      string
+	description  string
+	colorIndex   int
+	valueMapping [][]string
+}
+
+var errorValueMapping = [][]string{
+	{
+		"0",
+		"Healthy",
+		"#007305",
+	},
+	{
+		"9999999",
+		"Error",
+		"#9E003D",
+	},
+}
+
+func machineWidgets(projectId string, widgetType tilesWidgetType) ([]api.Widget, error) {
+	machines, err := projectAssets(projectId, CoffeeCloudMachineAssetType)
+	if err != nil {
+		return nil, err
+	}
+	widgets := []api.Widget{}
+	for _, machine := range machines {
+		widgets = append(widgets, widgetType.widget(machine.Id, []tile{
+			{attribute: "cup_count", subtype: "input", description: "Cups", colorIndex: 3},
+			{attribute: "hours_since_cleaned", subtype: "status", description: "Since clean", colorIndex: 1},
+			{attribute: "error_code", subtype: "status", description: "Status", colorIndex: 5, valueMapping: errorValueMapping},
+			{attribute: "error", subtype: "status", description: "Message", colorIndex: 0},
+		}))
+	}
+	return widgets, nil
+}
+
+func fleetOverviewWidgets(projectId string, widgetType tilesWidgetType) ([]api.Widget, error) {
+	roots, err := projectAssets(projectId, CoffeeCloudRootAssetType)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := projectAssets(projectId, CoffeeCloudGroupAssetType)
+	if err != nil {
+		return nil, err
+	}
+	widgets := []api.Widget{}
+	for _, group := range append(roots, groups...) {
+		widgets = append(widgets, widgetType.widget(group.Id, []tile{
+			{attribute: "machine_count", subtype: "status", description: "Machines", colorIndex: 0},
+			{attribute: "total_cups", subtype: "input", description: "Cups", colorIndex: 3},
+			{attribute: "machines_in_error", subtype: "status", description: "In error", colorIndex: 5},
+			{attribute: "machines_unhealthy", subtype: "status", description: "Unhealthy", colorIndex: 1},
+		}))
+	}
+	return widgets, nil
+}
 
-	machines, _, err := client.NewClient().AssetsAPI.
+func maintenanceWidgets(projectId string, widgetType tilesWidgetType) ([]api.Widget, error) {
+	return groupedWidgets(projectId, widgetType,
+		[]tile{
+			{attribute: "max_hours_since_cleaned", subtype: "status", description: "Max since clean", colorIndex: 1},
+			{attribute: "machines_unhealthy", subtype: "status", description: "Unhealthy", colorIndex: 5},
+		},
+		[]tile{
+			{attribute: "hours_since_cleaned", subtype: "status", description: "Since clean", colorIndex: 1},
+			{attribute: "cleanings_count", subtype: "status", description: "Cleanings", colorIndex: 3},
+			{attribute: "engine_status", subtype: "status", description: "Health", colorIndex: 5},
+			{attribute: "health_reason", subtype: "status", description: "Reason", colorIndex: 0},
+		})
+}
+
+func errorWidgets(projectId string, widgetType tilesWidgetType) ([]api.Widget, error) {
+	return groupedWidgets(projectId, widgetType,
+		[]tile{
+			{attribute: "machines_in_error", subtype: "status", description: "In error", colorIndex: 5},
+			{attribute: "machine_count", subtype: "status", description: "Machines", colorIndex: 0},
+		},
+		[]tile{
+			{attribute: "error_code", subtype: "status", description: "Status", colorIndex: 5, valueMapping: errorValueMapping},
+			{attribute: "error", subtype: "status", description: "Message", colorIndex: 0},
+			{attribute: "error_description", subtype: "status", description: "Description", colorIndex: 1},
+		})
+}
+
+// groupedWidgets creates a widget for each group followed by the widgets of the machines
+// placed directly below the group. Machines not below any group are appended at the end.
+func groupedWidgets(projectId string, widgetType tilesWidgetType, groupTiles []tile, machineTiles []tile) ([]api.Widget, error) {
+	groups, err := projectAssets(projectId, CoffeeCloudGroupAssetType)
+	if err != nil {
+		return nil, err
+	}
+	machines, err := projectAssets(projectId, CoffeeCloudMachineAssetType)
+	if err != nil {
+		return nil, err
+	}
+	groupIds := make(map[int32]bool)
+	for _, group := range groups {
+		groupIds[group.GetId()] = true
+	}
+	machinesByParent := make(map[int32][]api.Asset)
+	var ungrouped []api.Asset
+	for _, machine := range machines {
+		parentId := machine.GetParentLocationalAssetId()
+		if !groupIds[parentId] {
+			ungrouped = append(ungrouped, machine)
+			continue
+		}
+		machinesByParent[parentId] = append(machinesByParent[parentId], machine)
+	}
+
+	widgets := []api.Widget{}
+	for _, group := range groups {
+		widgets = append(widgets, widgetType.widget(group.Id, groupTiles))
+		for _, machine := range machinesByParent[group.GetId()] {
+			widgets = append(widgets, widgetType.widget(machine.Id, machineTiles))
+		}
+	}
+	for _, machine := range ungrouped {
+		widgets = append(widgets, widgetType.widget(machine.Id, machineTiles))
+	}
+	return widgets, nil
+}
+
+func projectAssets(projectId string, assetType string) ([]api.Asset, error) {
+	assets, _, err := client.NewClient().AssetsAPI.
 		GetAssets(client.AuthenticationContext()).
-		AssetTypeName(CoffeeCloudMachineAssetType).
+		AssetTypeName(assetType).
 		ProjectId(projectId).
 		Execute()
 	if err != nil {
-		return api.Dashboard{}, err
+		return nil, err
 	}
+	return assets, nil
+}
 
-	for _, machine := range machines {
-		widget := api.Widget{
-			WidgetTypeName: "coffecloud",
-			AssetId:        machine.Id,
-			Sequence:       nullableInt32(2),
-			Details: map[string]any{
-				"388": map[string]any{
-					"tilesConfig": []map[string]any{
-						{
-							"defaultColorIndex": 3,
-							"progressBar":       nil,
-							"valueMapping":      [][]string{},
-						},
-						{
-							"defaultColorIndex": 1,
-							"progressBar":       nil,
-							"valueMapping":      [][]string{},
-						},
-						{
-							"defaultColorIndex": 5,
-							"progressBar":       nil,
-							"valueMapping": [][]string{
-								{
-									"0",
-									"Healthy",
-									"#007305",
-								},
-								{
-									"9999999",
-									"Error",
-									"#9E003D",
-								},
-							},
-						},
-						{
-							"defaultColorIndex": 0,
-							"progressBar":       nil,
-							"valueMapping":      [][]string{},
-						},
-					},
-				},
-				"size":     1,
-				"timespan": 7,
-			},
-			Data: []api.WidgetData{
-				{
-					ElementSequence: nullableInt32(1),
-					AssetId:         machine.Id,
-					Data: map[string]interface{}{
-						"aggregatedDataField": nil,
-						"aggregatedDataType":  "heap",
-						"attribute":           "cup_count",
-						"description":         "Cups",
-						"key":                 "",
-						"seq":                 0,
-						"subtype":             "input",
-					},
-				},
-				{
-					ElementSequence: nullableInt32(1),
-					AssetId:         machine.Id,
-					Data: map[string]interface{}{
-						"aggregatedDataField": nil,
-						"aggregatedDataType":  "heap",
-						"attribute":           "hours_since_cleaned",
-						"description":         "Since clean",
-						"key":                 "",
-						"seq":                 1,
-						"subtype":             "status",
-					},
-				},
-				{
-					ElementSequence: nullableInt32(1),
-					AssetId:         machine.Id,
-					Data: map[string]interface{}{
-						"aggregatedDataField": nil,
-						"aggregatedDataType":  "heap",
-						"attribute":           "error_code",
-						"description":         "Status",
-						"key":                 "",
-						"seq":                 2,
-						"subtype":             "status",
-					},
-				},
-				{
-					ElementSequence: nullableInt32(1),
-					AssetId:         machine.Id,
-					Data: map[string]interface{}{
-						"aggregatedDataField": nil,
-						"aggregatedDataType":  "heap",
-						"attribute":           "error",
-						"description":         "Message",
-						"key":                 "",
-						"seq":                 3,
-						"subtype":             "status",
-					},
-				},
-			},
+// tilesWidgetTypeName is the name of the widget type defined by the app.
+const tilesWidgetTypeName = "coffecloud"
+
+// tilesElementSequence is the sequence of the tiles element within the widget type.
+const tilesElementSequence = 1
+
+// tilesWidgetType is the widget type of the app as defined in the Eliona installation. The
+// configuration of an element is keyed with the element ID, which differs between installations.
+type tilesWidgetType struct {
+	tilesElementId string
+}
+
+// getTilesWidgetType looks up the IDs of the widget type elements through the Eliona API.
+func getTilesWidgetType() (tilesWidgetType, error) {
+	widgetType, _, err := client.NewClient().WidgetsTypesAPI.
+		GetWidgetTypeByName(client.AuthenticationContext(), tilesWidgetTypeName).
+		Execute()
+	if err != nil {
+		return tilesWidgetType{}, fmt.Errorf("getting widget type %s: %w", tilesWidgetTypeName, err)
+	}
+	for _, element := range widgetType.Elements {
+		if element.GetSequence() == tilesElementSequence && element.Id.IsSet() && element.Id.Get() != nil {
+			return tilesWidgetType{tilesElementId: strconv.Itoa(int(element.GetId()))}, nil
 		}
+	}
+	return tilesWidgetType{}, fmt.Errorf("widget type %s has no element with sequence %d", tilesWidgetTypeName, tilesElementSequence)
+}
 
-		// add station widget to dashboard
-		dashboard.Widgets = append(dashboard.Widgets, widget)
+// widget creates a widget of the app's widget type showing the tiles for the asset.
+func (w tilesWidgetType) widget(assetId api.NullableInt32, tiles []tile) api.Widget {
+	tilesConfig := []map[string]any{}
+	data := []api.WidgetData{}
+	for i, t := range tiles {
+		valueMapping := t.valueMapping
+		if valueMapping == nil {
+			valueMapping = [][]string{}
+		}
+		tilesConfig = append(tilesConfig, map[string]any{
+			"defaultColorIndex": t.colorIndex,
+			"progressBar":       nil,
+			"valueMapping":      valueMapping,
+		})
+		data = append(data, api.WidgetData{
+			ElementSequence: nullableInt32(tilesElementSequence),
+			AssetId:         assetId,
+			Data: map[string]interface{}{
+				"aggregatedDataField": nil,
+				"aggregatedDataType":  "heap",
+				"attribute":           t.attribute,
+				"description":         t.description,
+				"key":                 "",
+				"seq":                 i,
+				"subtype":             t.subtype,
+			},
+		})
+	}
+	return api.Widget{
+		WidgetTypeName: tilesWidgetTypeName,
+		AssetId:        assetId,
+		Sequence:       nullableInt32(2),
+		Details: map[string]any{
+			w.tilesElementId: map[string]any{
+				"tilesConfig": tilesConfig,
+			},
+			"size":     1,
+			"timespan": 7,
+		},
+		Data: data,
 	}
-	return dashboard, nil
 }
 
 func nullableInt32(val int32) api.NullableInt32 {
@@ -1,25 +1,194 @@
 package eliona
 
 import (
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
 	"github.com/eliona-smart-building-assistant/go-eliona/asset"
 )
 
-func UpsertData(assetId int32, assetType string, data any) error {
-	subtypes := asset.SplitBySubtype(data)
+// UpsertData sends the data of each subtype to Eliona. The attributes of several data structs
+// are combined by subtype. Data unchanged since the last cycle is skipped, if a cache is given.
+func UpsertData(assetId int32, assetType string, cache *DataCache, data ...any) error {
+	subtypes := make(map[api.DataSubtype]map[string]interface{})
+	for _, d := range data {
+		for subtype, attributes := range asset.SplitBySubtype(d) {
+			if subtypes[subtype] == nil {
+				subtypes[subtype] = make(map[string]interface{})
+			}
+			for name, value := range attributes {
+				subtypes[subtype][name] = value
+			}
+		}
+	}
 	for subtype, data := range subtypes {
 		if subtype != "" {
+			if !cache.changed(assetId, subtype, data) {
+				continue
+			}
 			if err := asset.UpsertData(api.Data{
 				AssetId:       assetId,
 				Subtype:       subtype,
 				Data:          data,
 				AssetTypeName: *api.NewNullableString(&assetType),
 			}); err != nil {
+				cache.Invalidate(assetId)
 				return fmt.Errorf("upserting data for subtype %s: %w", subtype, err)
 			}
 		}
 	}
 	return nil
 }
+
+// DataCache remembers the data last sent for each asset and subtype, so that unchanged data
+// is not sent again. Unchanged data is still sent every heartbeat cycles.
+type DataCache struct {
+	mutex     sync.Mutex
+	heartbeat int
+	entries   map[dataKey]dataEntry
+}
+
+type dataKey struct {
+	assetId int32
+	subtype api.DataSubtype
+}
+
+type dataEntry struct {
+	hash    [sha256.Size]byte
+	skipped int
+}
+
+// NewDataCache creates a cache sending unchanged data again every heartbeat cycles.
+func NewDataCache(heartbeat int) *DataCache {
+	return &DataCache{
+		heartbeat: heartbeat,
+		entries:   make(map[dataKey]dataEntry),
+	}
+}
+
+// SetHeartbeat changes the number of cycles after which unchanged data is sent again.
+func (c *DataCache) SetHeartbeat(heartbeat int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.heartbeat = heartbeat
+}
+
+// Invalidate forces the data of the asset to be sent in the next cycle, e.g. after attributes
+// were written directly.
+func (c *DataCache) Invalidate(assetId int32) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for key := range c.entries {
+		if key.assetId == assetId {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// changed reports if the data has to be sent and remembers it as sent in that case.
+func (c *DataCache) changed(assetId int32, subtype api.DataSubtype, data map[string]interface{}) bool {
+	if c == nil {
+		return true
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return true
+	}
+	hash := sha256.Sum256(payload)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	key := dataKey{assetId: assetId, subtype: subtype}
+	entry, exists := c.entries[key]
+	if exists && entry.hash == hash && entry.skipped+1 < c.heartbeat {
+		entry.skipped++
+		c.entries[key] = entry
+		return false
+	}
+	c.entries[key] = dataEntry{hash: hash}
+	return true
+}
+
+// KPIs are aggregated over the machines of a group including its subgroups, or of all machines
+// for the root asset.
+type KPIs struct {
+	MachineCount         int `json:"machineCount" eliona:"machine_count" subtype:"status"`
+	TotalCups            int `json:"totalCups" eliona:"total_cups" subtype:"input"`
+	MachinesInError      int `json:"machinesInError" eliona:"machines_in_error" subtype:"status"`
+	MachinesUnhealthy    int `json:"machinesUnhealthy" eliona:"machines_unhealthy" subtype:"status"`
+	MaxHoursSinceCleaned int `json:"maxHoursSinceCleaned" eliona:"max_hours_since_cleaned" subtype:"status"`
+}
+
+// healthyStatus is the health status CoffeeCloud reports for machines without problems.
+const healthyStatus = "healthy"
+
+// ActiveErrorWindow is the time after an error during which the machine counts as in error. CoffeeCloud
+// does not report when an error is resolved, so older errors are considered resolved.
+const ActiveErrorWindow = 24 * time.Hour
+
+// AddMachine adds the machine to the KPIs.
+func (k *KPIs) AddMachine(machine Machine) {
+	k.MachineCount++
+	k.TotalCups += machine.CupCount
+	if machine.ErrorCode != 0 && machine.ErrorOccurredAt != nil && time.Since(*machine.ErrorOccurredAt) < ActiveErrorWindow {
+		k.MachinesInError++
+	}
+	if machine.EngineStatus != "" && !strings.EqualFold(machine.EngineStatus, healthyStatus) {
+		k.MachinesUnhealthy++
+	}
+	if machine.HoursSinceCleaned > k.MaxHoursSinceCleaned {
+		k.MaxHoursSinceCleaned = machine.HoursSinceCleaned
+	}
+}
+
+// Add adds the KPIs of a subgroup.
+func (k *KPIs) Add(other KPIs) {
+	k.MachineCount += other.MachineCount
+	k.TotalCups += other.TotalCups
+	k.MachinesInError += other.MachinesInError
+	k.MachinesUnhealthy += other.MachinesUnhealthy
+	if other.MaxHoursSinceCleaned > k.MaxHoursSinceCleaned {
+		k.MaxHoursSinceCleaned = other.MaxHoursSinceCleaned
+	}
+}
+
+// SetAssetActive sets the active attribute of a machine or group asset.
+func SetAssetActive(assetId int32, assetType string, active bool) error {
+	if err := asset.UpsertData(api.Data{
+		AssetId:       assetId,
+		Subtype:       api.SUBTYPE_STATUS,
+		Data:          map[string]interface{}{"active": active},
+		AssetTypeName: *api.NewNullableString(&assetType),
+	}); err != nil {
+		return fmt.Errorf("upserting active state: %w", err)
+	}
+	return nil
+}
+
+// UpsertErrorEvent writes the error with the time it occurred to the error attributes of
+// the machine, so that the history of the attributes contains every error.
+func UpsertErrorEvent(assetId int32, machineError MachineError) error {
+	assetType := CoffeeCloudMachineAssetType
+	if err := asset.UpsertData(api.Data{
+		AssetId:   assetId,
+		Subtype:   api.SUBTYPE_STATUS,
+		Timestamp: *api.NewNullableTime(&machineError.Timestamp),
+		Data: map[string]interface{}{
+			"error_code":        machineError.ErrorCode,
+			"error":             machineError.ErrorText,
+			"error_description": machineError.ErrorDescription,
+		},
+		AssetTypeName: *api.NewNullableString(&assetType),
+	}); err != nil {
+		return fmt.Errorf("upserting error event: %w", err)
+	}
+	return nil
+}
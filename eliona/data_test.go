@@ -0,0 +1,57 @@
+package eliona
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+func TestDataCache(t *testing.T) {
+	cache := NewDataCache(3)
+	data := map[string]interface{}{"cup_count": 1}
+
+	var sent []bool
+	for cycle := 0; cycle < 7; cycle++ {
+		sent = append(sent, cache.changed(1, api.SUBTYPE_INPUT, data))
+	}
+	want := []bool{true, false, false, true, false, false, true}
+	for cycle := range want {
+		if sent[cycle] != want[cycle] {
+			t.Errorf("cycle %d: sent %v, want %v", cycle, sent[cycle], want[cycle])
+		}
+	}
+
+	if !cache.changed(1, api.SUBTYPE_INPUT, map[string]interface{}{"cup_count": 2}) {
+		t.Errorf("changed data not sent")
+	}
+	if !cache.changed(1, api.SUBTYPE_STATUS, data) {
+		t.Errorf("data of other subtype not sent")
+	}
+	cache.Invalidate(1)
+	if !cache.changed(1, api.SUBTYPE_STATUS, data) {
+		t.Errorf("data not sent after invalidation")
+	}
+
+	var disabled *DataCache
+	if !disabled.changed(1, api.SUBTYPE_INPUT, data) || !disabled.changed(1, api.SUBTYPE_INPUT, data) {
+		t.Errorf("data not sent without cache")
+	}
+}
+
+func TestKPIs(t *testing.T) {
+	var group KPIs
+	group.AddMachine(Machine{CupCount: 10, EngineStatus: "healthy", HoursSinceCleaned: 5})
+	group.AddMachine(Machine{CupCount: 20, EngineStatus: "critical", ErrorCode: 42, ErrorOccurredAt: common.Ptr(time.Now().Add(-time.Hour)), HoursSinceCleaned: 30})
+	group.AddMachine(Machine{CupCount: 5, ErrorCode: 7, ErrorOccurredAt: common.Ptr(time.Now().Add(-ActiveErrorWindow - time.Hour)), HoursSinceCleaned: 12})
+
+	var root KPIs
+	root.AddMachine(Machine{CupCount: 1, HoursSinceCleaned: 50})
+	root.Add(group)
+
+	want := KPIs{MachineCount: 4, TotalCups: 36, MachinesInError: 1, MachinesUnhealthy: 1, MaxHoursSinceCleaned: 50}
+	if root != want {
+		t.Errorf("got %+v, want %+v", root, want)
+	}
+}
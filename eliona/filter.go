@@ -0,0 +1,239 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"coffeecloud/apiserver"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Operators of asset filter rules. Rules without operator use FilterOperatorRegex.
+const (
+	FilterOperatorRegex    = "regex"
+	FilterOperatorNotRegex = "not_regex"
+	FilterOperatorEq       = "eq"
+	FilterOperatorLt       = "lt"
+	FilterOperatorGt       = "gt"
+	FilterOperatorIn       = "in"
+	FilterOperatorNotIn    = "not_in"
+)
+
+// ValidateFilter checks that each rule of the filter has a parameter of groups or machines, a known
+// operator and a valid value for it.
+func ValidateFilter(filter [][]apiserver.FilterRule) error {
+	parameters, err := filterParameters()
+	if err != nil {
+		return err
+	}
+	for _, conjunction := range filter {
+		for _, rule := range conjunction {
+			if rule.Parameter == "" {
+				return fmt.Errorf("filter rule without parameter")
+			}
+			if !parameters[rule.Parameter] {
+				return fmt.Errorf("filter rule for %s: unknown parameter", rule.Parameter)
+			}
+			switch ruleOperator(rule) {
+			case FilterOperatorRegex, FilterOperatorNotRegex:
+				if _, err := regexp.Compile(rule.Regex); err != nil {
+					return fmt.Errorf("filter rule for %s: invalid regex %q: %v", rule.Parameter, rule.Regex, err)
+				}
+			case FilterOperatorEq:
+			case FilterOperatorLt, FilterOperatorGt:
+				if _, err := strconv.ParseFloat(rule.Value, 64); err != nil {
+					return fmt.Errorf("filter rule for %s: value %q is not a number", rule.Parameter, rule.Value)
+				}
+			case FilterOperatorIn, FilterOperatorNotIn:
+			default:
+				return fmt.Errorf("filter rule for %s: unknown operator %q", rule.Parameter, rule.Operator)
+			}
+		}
+	}
+	return nil
+}
+
+// filterParameters returns the parameters filterable on groups or machines.
+func filterParameters() (map[string]bool, error) {
+	parameters := make(map[string]bool)
+	for _, input := range []any{MachineGroup{}, Machine{}} {
+		properties, err := filterProperties(input)
+		if err != nil {
+			return nil, err
+		}
+		for parameter := range properties {
+			parameters[parameter] = true
+		}
+	}
+	return parameters, nil
+}
+
+// FilterProperties returns the filterable properties of the group or machine as used by the filter.
+func FilterProperties(input any) (map[string]string, error) {
+	return filterProperties(input)
+}
+
+// ExplainFilter checks whether the properties adhere to the filter and describes which rules decided
+// the result. The rule sets are joined by a logical disjunction and the rules of a set by a logical
+// conjunction. A rule on a parameter missing in the properties does not apply to them, e.g. a rule
+// on the firmware to a group, so groups are checked only by the rules on group parameters. An empty
+// filter matches all properties. If the properties match, the first matching rule set is described.
+// Otherwise, the first failing rule of each rule set is described.
+func ExplainFilter(filter [][]apiserver.FilterRule, properties map[string]string) (bool, string, error) {
+	if len(filter) == 0 {
+		return true, "no filter", nil
+	}
+	var failures []string
+	for i, conjunction := range filter {
+		var failure string
+		var applied []string
+		for _, rule := range conjunction {
+			value, exists := properties[rule.Parameter]
+			if !exists {
+				continue
+			}
+			match, err := evaluateRule(rule, value)
+			if err != nil {
+				return false, "", fmt.Errorf("applying filter rule for %s: %w", rule.Parameter, err)
+			}
+			if !match {
+				failure = fmt.Sprintf("rule set %d: %s not met by %q", i+1, describeRule(rule), value)
+				break
+			}
+			applied = append(applied, describeRule(rule))
+		}
+		if failure == "" {
+			if len(applied) == 0 {
+				return true, fmt.Sprintf("rule set %d matched: no rule applies", i+1), nil
+			}
+			return true, fmt.Sprintf("rule set %d matched: %s", i+1, strings.Join(applied, " and ")), nil
+		}
+		failures = append(failures, failure)
+	}
+	return false, strings.Join(failures, "; "), nil
+}
+
+// describeRule returns a readable form of the rule like "firmware lt 300".
+func describeRule(rule apiserver.FilterRule) string {
+	operator := ruleOperator(rule)
+	switch operator {
+	case FilterOperatorRegex, FilterOperatorNotRegex:
+		return fmt.Sprintf("%s %s %s", rule.Parameter, operator, rule.Regex)
+	case FilterOperatorIn, FilterOperatorNotIn:
+		return fmt.Sprintf("%s %s [%s]", rule.Parameter, operator, strings.Join(rule.Values, ", "))
+	default:
+		return fmt.Sprintf("%s %s %s", rule.Parameter, operator, rule.Value)
+	}
+}
+
+func evaluateRule(rule apiserver.FilterRule, value string) (bool, error) {
+	switch ruleOperator(rule) {
+	case FilterOperatorRegex, FilterOperatorNotRegex:
+		r, err := regexp.Compile(rule.Regex)
+		if err != nil {
+			return false, fmt.Errorf("compiling rule regexp %v: %v", rule.Regex, err)
+		}
+		return r.MatchString(value) == (ruleOperator(rule) == FilterOperatorRegex), nil
+	case FilterOperatorEq:
+		return equalValues(value, rule.Value), nil
+	case FilterOperatorLt, FilterOperatorGt:
+		limit, err := strconv.ParseFloat(rule.Value, 64)
+		if err != nil {
+			return false, fmt.Errorf("value %q is not a number", rule.Value)
+		}
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			// non-numeric values are neither less nor greater
+			return false, nil
+		}
+		if ruleOperator(rule) == FilterOperatorLt {
+			return number < limit, nil
+		}
+		return number > limit, nil
+	case FilterOperatorIn, FilterOperatorNotIn:
+		in := false
+		for _, v := range rule.Values {
+			if equalValues(value, v) {
+				in = true
+				break
+			}
+		}
+		return in == (ruleOperator(rule) == FilterOperatorIn), nil
+	default:
+		return false, fmt.Errorf("unknown operator %q", rule.Operator)
+	}
+}
+
+// ruleOperator returns the operator of the rule. Rules without operator are regex rules, as
+// before operators were introduced.
+func ruleOperator(rule apiserver.FilterRule) string {
+	if rule.Operator == "" {
+		return FilterOperatorRegex
+	}
+	return rule.Operator
+}
+
+// equalValues compares the values numerically if both are numbers and literally otherwise.
+func equalValues(a string, b string) bool {
+	numberA, errA := strconv.ParseFloat(a, 64)
+	numberB, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		return numberA == numberB
+	}
+	return a == b
+}
+
+// filterProperties returns the values of the fields marked with the eliona:"name,filterable" tag
+// as strings, keyed by the attribute name.
+func filterProperties(input any) (map[string]string, error) {
+	value := reflect.ValueOf(input)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("input is not a struct")
+	}
+	properties := make(map[string]string)
+	for i := 0; i < value.NumField(); i++ {
+		tag := strings.Split(value.Type().Field(i).Tag.Get("eliona"), ",")
+		filterable := false
+		for _, option := range tag[1:] {
+			filterable = filterable || option == "filterable"
+		}
+		if !filterable {
+			continue
+		}
+		properties[tag[0]] = propertyString(value.Field(i))
+	}
+	return properties, nil
+}
+
+func propertyString(value reflect.Value) string {
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+	if t, ok := value.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+	return fmt.Sprint(value.Interface())
+}
@@ -0,0 +1,187 @@
+package eliona
+
+import (
+	"coffeecloud/apiserver"
+	"testing"
+)
+
+func TestAdheresToFilter(t *testing.T) {
+	machine := Machine{
+		MachineName:  "brew-1",
+		SerialNumber: "SN1",
+		Firmware:     250,
+		GroupName:    "office",
+		CupCount:     42,
+	}
+	tests := []struct {
+		name   string
+		filter [][]apiserver.FilterRule
+		want   bool
+	}{
+		{
+			name: "no filter",
+			want: true,
+		},
+		{
+			name:   "regex without operator",
+			filter: [][]apiserver.FilterRule{{{Parameter: "machine_name", Regex: "^brew"}}},
+			want:   true,
+		},
+		{
+			name:   "not regex",
+			filter: [][]apiserver.FilterRule{{{Parameter: "machine_name", Operator: "not_regex", Regex: "^brew"}}},
+			want:   false,
+		},
+		{
+			name:   "numeric equal",
+			filter: [][]apiserver.FilterRule{{{Parameter: "firmware", Operator: "eq", Value: "250.0"}}},
+			want:   true,
+		},
+		{
+			name:   "less than",
+			filter: [][]apiserver.FilterRule{{{Parameter: "firmware", Operator: "lt", Value: "300"}}},
+			want:   true,
+		},
+		{
+			name:   "greater than",
+			filter: [][]apiserver.FilterRule{{{Parameter: "cup_count", Operator: "gt", Value: "42"}}},
+			want:   false,
+		},
+		{
+			name:   "in list",
+			filter: [][]apiserver.FilterRule{{{Parameter: "serial_number", Operator: "in", Values: []string{"SN1", "SN2"}}}},
+			want:   true,
+		},
+		{
+			name:   "not in list",
+			filter: [][]apiserver.FilterRule{{{Parameter: "serial_number", Operator: "not_in", Values: []string{"SN1"}}}},
+			want:   false,
+		},
+		{
+			name: "conjunction",
+			filter: [][]apiserver.FilterRule{{
+				{Parameter: "firmware", Operator: "lt", Value: "300"},
+				{Parameter: "machine_name", Operator: "not_regex", Regex: "^test"},
+			}},
+			want: true,
+		},
+		{
+			name: "disjunction",
+			filter: [][]apiserver.FilterRule{
+				{{Parameter: "firmware", Operator: "gt", Value: "300"}},
+				{{Parameter: "serial_number", Operator: "eq", Value: "SN1"}},
+			},
+			want: true,
+		},
+		{
+			name: "not regex on group name",
+			filter: [][]apiserver.FilterRule{{
+				{Parameter: "firmware", Operator: "lt", Value: "300"},
+				{Parameter: "group_name", Operator: "not_regex", Regex: "^test"},
+			}},
+			want: true,
+		},
+		{
+			name:   "group name excluded",
+			filter: [][]apiserver.FilterRule{{{Parameter: "group_name", Operator: "not_regex", Regex: "^office"}}},
+			want:   false,
+		},
+		{
+			name:   "rule on group parameter",
+			filter: [][]apiserver.FilterRule{{{Parameter: "group_id", Operator: "eq", Value: "7"}}},
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AdheresToFilter(machine, tt.filter)
+			if err != nil {
+				t.Fatalf("AdheresToFilter() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("AdheresToFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    apiserver.FilterRule
+		wantErr bool
+	}{
+		{name: "regex", rule: apiserver.FilterRule{Parameter: "machine_name", Regex: "^brew"}},
+		{name: "invalid regex", rule: apiserver.FilterRule{Parameter: "machine_name", Regex: "("}, wantErr: true},
+		{name: "numeric value", rule: apiserver.FilterRule{Parameter: "firmware", Operator: "lt", Value: "300"}},
+		{name: "non-numeric value", rule: apiserver.FilterRule{Parameter: "firmware", Operator: "gt", Value: "new"}, wantErr: true},
+		{name: "unknown operator", rule: apiserver.FilterRule{Parameter: "firmware", Operator: "ne", Value: "300"}, wantErr: true},
+		{name: "missing parameter", rule: apiserver.FilterRule{Regex: "^brew"}, wantErr: true},
+		{name: "group parameter", rule: apiserver.FilterRule{Parameter: "group_id", Operator: "eq", Value: "7"}},
+		{name: "unknown parameter", rule: apiserver.FilterRule{Parameter: "location", Regex: "^Zurich"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFilter([][]apiserver.FilterRule{{tt.rule}})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExplainFilter(t *testing.T) {
+	properties := map[string]string{"serial_number": "SN1", "firmware": "350", "group_name": "test-lab"}
+	filter := [][]apiserver.FilterRule{
+		{{Parameter: "firmware", Operator: "lt", Value: "300"}},
+		{{Parameter: "group_name", Regex: "^brew"}},
+	}
+	included, reason, err := ExplainFilter(filter, properties)
+	if err != nil {
+		t.Fatalf("ExplainFilter() error = %v", err)
+	}
+	want := `rule set 1: firmware lt 300 not met by "350"; rule set 2: group_name regex ^brew not met by "test-lab"`
+	if included || reason != want {
+		t.Errorf("ExplainFilter() = %v, %q, want false, %q", included, reason, want)
+	}
+
+	filter = append(filter, []apiserver.FilterRule{{Parameter: "serial_number", Operator: "in", Values: []string{"SN1", "SN2"}}})
+	included, reason, err = ExplainFilter(filter, properties)
+	if err != nil {
+		t.Fatalf("ExplainFilter() error = %v", err)
+	}
+	want = "rule set 3 matched: serial_number in [SN1, SN2]"
+	if !included || reason != want {
+		t.Errorf("ExplainFilter() = %v, %q, want true, %q", included, reason, want)
+	}
+}
+
+func TestFilterGroupsAndMachines(t *testing.T) {
+	// Groups are checked by the rules on group parameters only, machines by all rules
+	filter := [][]apiserver.FilterRule{{
+		{Parameter: "firmware", Operator: "lt", Value: "300"},
+		{Parameter: "group_name", Operator: "not_regex", Regex: "^test"},
+	}}
+	tests := []struct {
+		name  string
+		input any
+		want  bool
+	}{
+		{"group", MachineGroup{GroupID: "1", GroupName: "office"}, true},
+		{"test group", MachineGroup{GroupID: "2", GroupName: "test-lab"}, false},
+		{"machine", Machine{Firmware: 250, GroupName: "office"}, true},
+		{"machine in test group", Machine{Firmware: 250, GroupName: "test-lab"}, false},
+		{"machine with new firmware", Machine{Firmware: 350, GroupName: "office"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AdheresToFilter(tt.input, filter)
+			if err != nil {
+				t.Fatalf("AdheresToFilter() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("AdheresToFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
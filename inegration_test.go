@@ -27,5 +27,5 @@ func assetTypes(t *testing.T) {
 func schema(t *testing.T) {
 	t.Parallel()
 
-	assert.SchemaExists(t, "coffeecloud", []string{"asset", "configuration"})
+	assert.SchemaExists(t, "coffeecloud", []string{"asset", "configuration", "machine_error", "machine_error_cursor", "machine_state", "machine_move", "machine_cleaning"})
 }
@@ -0,0 +1,135 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"coffeecloud/apiserver"
+	"coffeecloud/coffeecloud"
+	"coffeecloud/conf"
+	"coffeecloud/eliona"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// previewTimeout limits the time a filter preview may take, as the requests wait for the rate limit
+// shared with the collection cycles.
+const previewTimeout = 2 * time.Minute
+
+// previewFilter collects all groups and machines of the configuration and evaluates the filter for
+// each of them. Nothing is written to Eliona or the database, and the data cached for the collection
+// cycles is left unchanged. The machines are listed below the group they are placed in.
+// The preview is canceled with the context or after previewTimeout.
+func previewFilter(ctx context.Context, config apiserver.Configuration, filter [][]apiserver.FilterRule) ([]apiserver.FilterPreviewGroup, error) {
+	ctx, cancel := context.WithTimeout(ctx, previewTimeout)
+	defer cancel()
+
+	// Collect without the configured filter to evaluate the candidate for everything
+	config.AssetFilter = nil
+
+	// Share the auth token and rate limit with the collection cycles, but not their retry budget
+	client, err := coffeeCloudClient(config)
+	if err != nil {
+		return nil, err
+	}
+	client.SetRequestsPerMinute(common.Val(config.RequestsPerMinute))
+	client = client.WithContext(ctx)
+
+	ccGroups, err := client.GetGroups()
+	if err != nil {
+		return nil, fmt.Errorf("getting groups: %w", err)
+	}
+	ccGroups = coffeecloud.FlattenGroups(ccGroups)
+	errorCursor, err := conf.GetMachineErrorCursor(ctx, *config.Id)
+	if err != nil {
+		return nil, fmt.Errorf("getting machine error cursor: %w", err)
+	}
+	eliGroups, failures, err := collectGroups(config, client, ccGroups, coffeecloud.Criteria{}, errorCursor.FetchFrom)
+	if err != nil {
+		return nil, err
+	}
+	// Groups failed by the cancellation would show the data of the last cycle
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("previewing filter: %w", err)
+	}
+	for _, failure := range failures {
+		log.Warn("coffeecloud", "Previewing filter for config %d: %v", *config.Id, failure)
+	}
+
+	previews := make([]apiserver.FilterPreviewGroup, len(eliGroups))
+	groupIndexes := make(map[string]int)
+	groupIncluded := make(map[string]bool)
+	for i, eliGroup := range eliGroups {
+		properties, included, reason, err := explainFilter(eliGroup, filter)
+		if err != nil {
+			return nil, fmt.Errorf("filtering group %s: %w", eliGroup.GroupName, err)
+		}
+		previews[i] = apiserver.FilterPreviewGroup{
+			GroupId:    eliGroup.GroupID,
+			GroupName:  eliGroup.GroupName,
+			Properties: properties,
+			Included:   included,
+			Reason:     reason,
+		}
+		groupIndexes[eliGroup.GroupID] = i
+		groupIncluded[eliGroup.GroupID] = included
+	}
+
+	// Machines are collected with each group containing them, so a machine is only excluded
+	// by its groups if none of them is included
+	machineIncludedByGroup := make(map[string]bool)
+	for _, eliGroup := range eliGroups {
+		for _, machine := range eliGroup.Machines {
+			machineIncludedByGroup[machine.SerialNumber] = machineIncludedByGroup[machine.SerialNumber] || groupIncluded[eliGroup.GroupID]
+		}
+	}
+
+	for _, eliGroup := range arrangeGroupHierarchy(ccGroups, eliGroups) {
+		preview := &previews[groupIndexes[eliGroup.GroupID]]
+		for _, machine := range eliGroup.Machines {
+			properties, included, reason, err := explainFilter(machine, filter)
+			if err != nil {
+				return nil, fmt.Errorf("filtering machine %s: %w", machine.MachineName, err)
+			}
+			if included && !machineIncludedByGroup[machine.SerialNumber] {
+				included, reason = false, "no group of the machine is included"
+			}
+			preview.Machines = append(preview.Machines, apiserver.FilterPreviewMachine{
+				SerialNumber: machine.SerialNumber,
+				MachineName:  machine.MachineName,
+				Properties:   properties,
+				Included:     included,
+				Reason:       reason,
+			})
+		}
+	}
+	return previews, nil
+}
+
+func explainFilter(input any, filter [][]apiserver.FilterRule) (map[string]string, bool, string, error) {
+	properties, err := eliona.FilterProperties(input)
+	if err != nil {
+		return nil, false, "", err
+	}
+	included, reason, err := eliona.ExplainFilter(filter, properties)
+	if err != nil {
+		return nil, false, "", err
+	}
+	return properties, included, reason, nil
+}
@@ -0,0 +1,146 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"coffeecloud/apiserver"
+	"coffeecloud/appdb"
+	"coffeecloud/conf"
+	"coffeecloud/eliona"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// Policies for assets of machines and groups no longer present in CoffeeCloud.
+const (
+	removedAssetPolicyKeep     = "keep"
+	removedAssetPolicyInactive = "inactive"
+	removedAssetPolicyArchive  = "archive"
+	removedAssetPolicyDelete   = "delete"
+)
+
+func removedAssetPolicy(config apiserver.Configuration) string {
+	switch policy := common.Val(config.RemovedAssetPolicy); policy {
+	case removedAssetPolicyKeep, removedAssetPolicyInactive, removedAssetPolicyArchive, removedAssetPolicyDelete:
+		return policy
+	case "":
+		return removedAssetPolicyInactive
+	default:
+		log.Warn("assets", "unknown removed asset policy %s in configuration %d, using %s", policy, *config.Id, removedAssetPolicyInactive)
+		return removedAssetPolicyInactive
+	}
+}
+
+// reconcileAssets handles the assets of the project which were created for machines and groups
+// no longer present in the collected groups. Nothing is done if a group could not be collected
+// completely, because its machines are not known for sure.
+func reconcileAssets(config apiserver.Configuration, projectId string, rootAssetId int32, groups []eliona.MachineGroup) error {
+	policy := removedAssetPolicy(config)
+	if policy == removedAssetPolicyKeep {
+		return nil
+	}
+	for _, group := range groups {
+		if group.Stale {
+			log.Debug("assets", "skipping reconciliation of project %s, group %s is stale", projectId, group.GroupName)
+			return nil
+		}
+	}
+
+	present := map[string]bool{
+		assetIdentifier(eliona.CoffeeCloudRootAssetType, eliona.CoffeeCloudRootAssetType):                         true,
+		assetIdentifier(eliona.CoffeeCloudGroupAssetType, eliona.CoffeeCloudGroupAssetType+"_"+archiveIdentifier): true,
+	}
+	for _, group := range groups {
+		present[assetIdentifier(eliona.CoffeeCloudGroupAssetType, eliona.CoffeeCloudGroupAssetType+"_"+group.GroupID)] = true
+		for _, machine := range group.Machines {
+			present[assetIdentifier(eliona.CoffeeCloudMachineAssetType, eliona.CoffeeCloudMachineAssetType+"_"+machine.MachineID)] = true
+		}
+	}
+
+	ctx := context.Background()
+	dbAssets, err := conf.GetAssets(ctx, *config.Id, projectId)
+	if err != nil {
+		return fmt.Errorf("getting assets of project %s: %w", projectId, err)
+	}
+	var removed []*appdb.Asset
+	for _, dbAsset := range dbAssets {
+		if !present[dbAsset.Identifier] && !dbAsset.RemovedAt.Valid && dbAsset.AssetID.Valid {
+			removed = append(removed, dbAsset)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	// Handle machines before groups, so that children are handled before their parents
+	sort.SliceStable(removed, func(i, j int) bool {
+		return strings.HasPrefix(removed[i].Identifier, eliona.CoffeeCloudMachineAssetType) &&
+			!strings.HasPrefix(removed[j].Identifier, eliona.CoffeeCloudMachineAssetType)
+	})
+
+	var archiveAssetId int32
+	if policy == removedAssetPolicyArchive {
+		archiveAssetId, err = createOrUpdateAsset(config, projectId, eliona.CoffeeCloudGroupAssetType+"_"+archiveIdentifier, &rootAssetId, eliona.CoffeeCloudGroupAssetType, "Archived", nil)
+		if err != nil {
+			return fmt.Errorf("create archive asset: %w", err)
+		}
+	}
+
+	for _, dbAsset := range removed {
+		assetId := dbAsset.AssetID.Int32
+		log.Info("assets", "asset %s with id %d is no longer present, applying policy %s", dbAsset.Identifier, assetId, policy)
+		switch policy {
+		case removedAssetPolicyDelete:
+			if err := eliona.DeleteAsset(assetId); err != nil {
+				return err
+			}
+			if err := conf.DeleteAsset(ctx, dbAsset); err != nil {
+				return fmt.Errorf("delete asset %s in app: %w", dbAsset.Identifier, err)
+			}
+			continue
+		case removedAssetPolicyArchive:
+			if err := eliona.MoveAsset(assetId, archiveAssetId); err != nil {
+				return err
+			}
+		}
+		if err := eliona.SetAssetActive(assetId, assetTypeOf(dbAsset.Identifier), false); err != nil {
+			return fmt.Errorf("deactivating asset %s: %w", dbAsset.Identifier, err)
+		}
+		dataCache(config).Invalidate(assetId)
+		if err := conf.SetAssetRemoved(ctx, dbAsset, common.Ptr(time.Now())); err != nil {
+			return fmt.Errorf("mark asset %s as removed in app: %w", dbAsset.Identifier, err)
+		}
+	}
+	return nil
+}
+
+// archiveIdentifier identifies the group asset holding the archived assets.
+const archiveIdentifier = "archive"
+
+// assetTypeOf returns the asset type the identifier is namespaced with.
+func assetTypeOf(identifier string) string {
+	for _, assetType := range []string{eliona.CoffeeCloudMachineAssetType, eliona.CoffeeCloudGroupAssetType} {
+		if strings.HasPrefix(identifier, assetType+"_") {
+			return assetType
+		}
+	}
+	return eliona.CoffeeCloudRootAssetType
+}
@@ -0,0 +1,146 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package simulator
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// Fleet is the content served by the simulator. It can be generated with GenerateFleet
+// or loaded from a JSON file.
+type Fleet struct {
+	Groups []Group `json:"groups"`
+}
+
+// Group is a CoffeeCloud group. The machines of a group include the machines of its children.
+type Group struct {
+	ID       uint      `json:"id"`
+	Name     string    `json:"name"`
+	Machines []Machine `json:"machines,omitempty"`
+	Children []Group   `json:"children,omitempty"`
+}
+
+type Machine struct {
+	ID              string    `json:"id"`
+	Name            string    `json:"name"`
+	SerialNumber    string    `json:"serialNumber"`
+	Firmware        int       `json:"firmware"`
+	Cups            int       `json:"cups"`
+	HoursSinceClean int       `json:"hoursSinceClean"`
+	Location        []float64 `json:"location,omitempty"`
+	Errors          []Error   `json:"errors,omitempty"`
+	Health          Health    `json:"health"`
+}
+
+type Error struct {
+	Code  int       `json:"code"`
+	Text  string    `json:"text"`
+	Short string    `json:"short"`
+	Time  time.Time `json:"time"`
+}
+
+type Health struct {
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+	Cause  string `json:"cause,omitempty"`
+}
+
+// FleetOptions describes the size of a generated fleet.
+type FleetOptions struct {
+	// Groups is the number of top level groups.
+	Groups int
+	// SubGroups is the number of child groups of each group above the maximum depth.
+	SubGroups int
+	// Depth is the number of nested levels below the top level groups.
+	Depth int
+	// MachinesPerGroup is the number of machines placed directly in each group.
+	MachinesPerGroup int
+	// ErrorsPerMachine is the number of errors in the history of each machine.
+	ErrorsPerMachine int
+	// Seed makes the generated values reproducible.
+	Seed int64
+}
+
+// GenerateFleet creates a fleet with random but reproducible machine data.
+func GenerateFleet(options FleetOptions) Fleet {
+	generator := fleetGenerator{
+		options: options,
+		random:  rand.New(rand.NewSource(options.Seed)),
+		now:     time.Now().Truncate(time.Millisecond),
+	}
+	var fleet Fleet
+	for i := 0; i < options.Groups; i++ {
+		fleet.Groups = append(fleet.Groups, generator.group(fmt.Sprintf("Group %d", i+1), 0))
+	}
+	return fleet
+}
+
+type fleetGenerator struct {
+	options  FleetOptions
+	random   *rand.Rand
+	now      time.Time
+	groups   uint
+	machines int
+}
+
+func (g *fleetGenerator) group(name string, depth int) Group {
+	g.groups++
+	group := Group{
+		ID:   g.groups,
+		Name: name,
+	}
+	for i := 0; i < g.options.MachinesPerGroup; i++ {
+		group.Machines = append(group.Machines, g.machine())
+	}
+	if depth < g.options.Depth {
+		for i := 0; i < g.options.SubGroups; i++ {
+			group.Children = append(group.Children, g.group(fmt.Sprintf("%s.%d", name, i+1), depth+1))
+		}
+	}
+	return group
+}
+
+var healthStates = []Health{
+	{Status: "healthy"},
+	{Status: "warning", Reason: "cleaning overdue", Cause: "no cleaning for more than 24 hours"},
+	{Status: "critical", Reason: "grinder blocked", Cause: "error 42 reported repeatedly"},
+}
+
+func (g *fleetGenerator) machine() Machine {
+	g.machines++
+	machine := Machine{
+		ID:              fmt.Sprintf("machine-%d", g.machines),
+		Name:            fmt.Sprintf("Machine %d", g.machines),
+		SerialNumber:    fmt.Sprintf("SN%05d", g.machines),
+		Firmware:        100 + g.random.Intn(20),
+		Cups:            g.random.Intn(10000),
+		HoursSinceClean: g.random.Intn(48),
+		Location:        []float64{8.5 + g.random.Float64(), 47 + g.random.Float64()},
+		Health:          healthStates[g.random.Intn(len(healthStates))],
+	}
+	for i := 0; i < g.options.ErrorsPerMachine; i++ {
+		code := 1 + g.random.Intn(99)
+		machine.Errors = append(machine.Errors, Error{
+			Code:  code,
+			Text:  fmt.Sprintf("Error %d occurred", code),
+			Short: fmt.Sprintf("E%d", code),
+			Time:  g.now.Add(-time.Duration(g.random.Intn(30*24)) * time.Hour),
+		})
+	}
+	return machine
+}
@@ -0,0 +1,564 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package simulator provides a fake CoffeeCloud API for development and tests. It serves
+// a configurable fleet of groups and machines and can inject faults like rejected tokens,
+// rate limits, server errors and slow responses.
+package simulator
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultUsername = "simulator"
+	DefaultPassword = "simulator"
+	DefaultAPIKey   = "simulator"
+)
+
+// Simulator is an http.Handler implementing the CoffeeCloud endpoints used by the app.
+type Simulator struct {
+	mutex         sync.Mutex
+	fleet         Fleet
+	username      string
+	password      string
+	apiKey        string
+	tokenLifetime time.Duration
+	tokens        map[string]time.Time
+	issued        int
+	faults        []*Fault
+	requests      map[string]int
+}
+
+// Fault changes the responses to requests matching the path.
+type Fault struct {
+	// Path restricts the fault to one endpoint, e.g. "/rest/groups". Empty matches all endpoints.
+	Path string `json:"path,omitempty"`
+	// StatusCode is returned instead of the regular response, if set.
+	StatusCode int `json:"statusCode,omitempty"`
+	// RetryAfter is set as Retry-After header of the error response.
+	RetryAfter string `json:"retryAfter,omitempty"`
+	// Delay is waited before the response is sent.
+	Delay time.Duration `json:"-"`
+	// Count is the number of requests affected. Zero or less affects all requests.
+	Count int `json:"count,omitempty"`
+}
+
+// New creates a simulator serving the fleet with the default credentials.
+func New(fleet Fleet) *Simulator {
+	return &Simulator{
+		fleet:         fleet,
+		username:      DefaultUsername,
+		password:      DefaultPassword,
+		apiKey:        DefaultAPIKey,
+		tokenLifetime: time.Hour,
+		tokens:        make(map[string]time.Time),
+		requests:      make(map[string]int),
+	}
+}
+
+// NewServer starts a test server with a new simulator. The server has to be closed by the caller.
+func NewServer(fleet Fleet) (*Simulator, *httptest.Server) {
+	simulator := New(fleet)
+	return simulator, httptest.NewServer(simulator)
+}
+
+// SetCredentials changes the credentials accepted by the simulator.
+func (s *Simulator) SetCredentials(username string, password string, apiKey string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.username = username
+	s.password = password
+	s.apiKey = apiKey
+}
+
+// SetTokenLifetime changes the lifetime of newly issued tokens.
+func (s *Simulator) SetTokenLifetime(lifetime time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.tokenLifetime = lifetime
+}
+
+// RevokeTokens invalidates all issued tokens, so that the next requests are rejected with 401.
+func (s *Simulator) RevokeTokens() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.tokens = make(map[string]time.Time)
+}
+
+// InjectFault adds a fault. Faults are applied in the order they were added.
+func (s *Simulator) InjectFault(fault Fault) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all faults.
+func (s *Simulator) ClearFaults() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.faults = nil
+}
+
+// UpdateMachine changes the machine with the serial number, e.g. to simulate a cleaning.
+// It returns false if there is no such machine.
+func (s *Simulator) UpdateMachine(serialNumber string, update func(machine *Machine)) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var updateIn func(groups []Group) bool
+	updateIn = func(groups []Group) bool {
+		for i := range groups {
+			for j := range groups[i].Machines {
+				if groups[i].Machines[j].SerialNumber == serialNumber {
+					update(&groups[i].Machines[j])
+					return true
+				}
+			}
+			if updateIn(groups[i].Children) {
+				return true
+			}
+		}
+		return false
+	}
+	return updateIn(s.fleet.Groups)
+}
+
+// Requests returns the number of requests received for the path.
+func (s *Simulator) Requests(path string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.requests[path]
+}
+
+// Logins returns the number of tokens issued.
+func (s *Simulator) Logins() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.issued
+}
+
+func (s *Simulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path
+	if strings.HasPrefix(path, "/simulator/") {
+		s.serveControl(w, r)
+		return
+	}
+
+	s.mutex.Lock()
+	s.requests[path]++
+	fault := s.takeFault(path)
+	s.mutex.Unlock()
+
+	if fault != nil {
+		if fault.Delay > 0 {
+			select {
+			case <-time.After(fault.Delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if fault.StatusCode != 0 {
+			if fault.RetryAfter != "" {
+				w.Header().Set("Retry-After", fault.RetryAfter)
+			}
+			http.Error(w, http.StatusText(fault.StatusCode), fault.StatusCode)
+			return
+		}
+	}
+
+	if path == "/rest/login" {
+		s.serveLogin(w, r)
+		return
+	}
+	if !s.authorized(r) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	switch path {
+	case "/rest/groups":
+		s.serveGroups(w, r)
+	case "/rest/overview/data":
+		s.serveMachines(w, r)
+	case "/rest/dashboard/error/search":
+		s.serveErrors(w, r)
+	case "/rest/dashboard/healthkpi":
+		s.serveHealth(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// takeFault returns the first fault matching the path and counts it down.
+func (s *Simulator) takeFault(path string) *Fault {
+	for i, fault := range s.faults {
+		if fault.Path != "" && fault.Path != path {
+			continue
+		}
+		if fault.Count > 0 {
+			fault.Count--
+			if fault.Count == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		taken := *fault
+		return &taken
+	}
+	return nil
+}
+
+// serveControl allows to inject and clear faults of a running simulator. The delay of an
+// injected fault is given as duration string, e.g. {"path": "/rest/groups", "delay": "5s"}.
+func (s *Simulator) serveControl(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/simulator/faults" {
+		http.NotFound(w, r)
+		return
+	}
+	switch r.Method {
+	case http.MethodPost:
+		var fault struct {
+			Fault
+			Delay string `json:"delay,omitempty"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&fault); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if fault.Delay != "" {
+			delay, err := time.ParseDuration(fault.Delay)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			fault.Fault.Delay = delay
+		}
+		s.InjectFault(fault.Fault)
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		s.ClearFaults()
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Simulator) serveLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var login struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&login); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mutex.Lock()
+	if login.Username != s.username || login.Password != s.password {
+		s.mutex.Unlock()
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	s.issued++
+	expiresAt := time.Now().Add(s.tokenLifetime)
+	token := newToken(s.issued, expiresAt)
+	s.tokens[token] = expiresAt
+	s.mutex.Unlock()
+
+	writeJSON(w, map[string]string{"id_token": token})
+}
+
+// newToken creates a JWT shaped token with an expiry claim. The token is not signed.
+func newToken(id int, expiresAt time.Time) string {
+	encode := func(value any) string {
+		payload, _ := json.Marshal(value)
+		return base64.RawURLEncoding.EncodeToString(payload)
+	}
+	header := encode(map[string]string{"alg": "none", "typ": "JWT"})
+	claims := encode(map[string]any{"sub": DefaultUsername, "jti": strconv.Itoa(id), "exp": expiresAt.Unix()})
+	return header + "." + claims + ".simulator"
+}
+
+func (s *Simulator) authorized(r *http.Request) bool {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if r.Header.Get("API-Key") != s.apiKey {
+		return false
+	}
+	expiresAt, exists := s.tokens[token]
+	return exists && time.Now().Before(expiresAt)
+}
+
+type groupResponse struct {
+	ID            uint            `json:"id"`
+	Name          string          `json:"name"`
+	ParentID      *uint           `json:"parentId"`
+	SerialNumbers []string        `json:"serialNumbers"`
+	Children      []groupResponse `json:"children,omitempty"`
+}
+
+func (s *Simulator) serveGroups(w http.ResponseWriter, _ *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var convert func(groups []Group, parentID *uint) []groupResponse
+	convert = func(groups []Group, parentID *uint) []groupResponse {
+		var converted []groupResponse
+		for _, group := range groups {
+			id := group.ID
+			response := groupResponse{
+				ID:       group.ID,
+				Name:     group.Name,
+				ParentID: parentID,
+				Children: convert(group.Children, &id),
+			}
+			for _, machine := range machinesOf(group) {
+				response.SerialNumbers = append(response.SerialNumbers, machine.SerialNumber)
+			}
+			converted = append(converted, response)
+		}
+		return converted
+	}
+	writeJSON(w, convert(s.fleet.Groups, nil))
+}
+
+type searchBody struct {
+	Criteria map[string]map[string]json.RawMessage `json:"criteria"`
+	Limit    int                                   `json:"limit"`
+	Offset   int                                   `json:"offset"`
+}
+
+// criteria evaluates the supported search operators: $in on origin.sn and $gte/$lt on
+// timestamp.milliseconds.
+type criteria struct {
+	serialNumbers map[string]bool
+	from          *int64
+	to            *int64
+}
+
+func parseSearchBody(r *http.Request) (searchBody, criteria, error) {
+	var body searchBody
+	var parsed criteria
+	if r.Method != http.MethodPost {
+		return body, parsed, fmt.Errorf("method %s not allowed", r.Method)
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return body, parsed, fmt.Errorf("decoding body: %w", err)
+	}
+	if in, exists := body.Criteria["origin.sn"]["$in"]; exists {
+		var serialNumbers []string
+		if err := json.Unmarshal(in, &serialNumbers); err != nil {
+			return body, parsed, fmt.Errorf("decoding origin.sn: %w", err)
+		}
+		parsed.serialNumbers = make(map[string]bool)
+		for _, serialNumber := range serialNumbers {
+			parsed.serialNumbers[serialNumber] = true
+		}
+	}
+	for operator, target := range map[string]**int64{"$gte": &parsed.from, "$lt": &parsed.to} {
+		if value, exists := body.Criteria["timestamp.milliseconds"][operator]; exists {
+			var milliseconds int64
+			if err := json.Unmarshal(value, &milliseconds); err != nil {
+				return body, parsed, fmt.Errorf("decoding timestamp.milliseconds: %w", err)
+			}
+			*target = &milliseconds
+		}
+	}
+	return body, parsed, nil
+}
+
+func (c criteria) matchesMachine(machine Machine) bool {
+	return c.serialNumbers == nil || c.serialNumbers[machine.SerialNumber]
+}
+
+func (c criteria) matchesTime(t time.Time) bool {
+	milliseconds := t.UnixMilli()
+	return (c.from == nil || milliseconds >= *c.from) && (c.to == nil || milliseconds < *c.to)
+}
+
+type page struct {
+	Count  int   `json:"count"`
+	Offset int   `json:"offset"`
+	Limit  int   `json:"limit"`
+	Result []any `json:"result"`
+}
+
+func paginate(records []any, offset int, limit int) page {
+	result := page{Count: len(records), Offset: offset, Limit: limit, Result: []any{}}
+	if offset < 0 || offset >= len(records) {
+		return result
+	}
+	end := len(records)
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+	result.Result = records[offset:end]
+	return result
+}
+
+type origin struct {
+	SerialNumber string `json:"sn"`
+	Firmware     int    `json:"fw,omitempty"`
+}
+
+func (s *Simulator) serveMachines(w http.ResponseWriter, r *http.Request) {
+	body, criteria, err := parseSearchBody(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	machines, ok := s.requestedMachines(w, r)
+	if !ok {
+		return
+	}
+	var records []any
+	for _, machine := range machines {
+		if !criteria.matchesMachine(machine) {
+			continue
+		}
+		records = append(records, map[string]any{
+			"id":              machine.ID,
+			"machineName":     machine.Name,
+			"origin":          origin{SerialNumber: machine.SerialNumber, Firmware: machine.Firmware},
+			"numberOfCups":    machine.Cups,
+			"relay":           map[string]any{"location": machine.Location},
+			"hoursSinceClean": machine.HoursSinceClean,
+		})
+	}
+	writeJSON(w, paginate(records, body.Offset, body.Limit))
+}
+
+func (s *Simulator) serveErrors(w http.ResponseWriter, r *http.Request) {
+	body, criteria, err := parseSearchBody(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	machines, ok := s.requestedMachines(w, r)
+	if !ok {
+		return
+	}
+	type machineError struct {
+		serialNumber string
+		Error
+	}
+	var machineErrors []machineError
+	for _, machine := range machines {
+		if !criteria.matchesMachine(machine) {
+			continue
+		}
+		for _, e := range machine.Errors {
+			if criteria.matchesTime(e.Time) {
+				machineErrors = append(machineErrors, machineError{machine.SerialNumber, e})
+			}
+		}
+	}
+	sort.SliceStable(machineErrors, func(i, j int) bool {
+		return machineErrors[i].Time.After(machineErrors[j].Time)
+	})
+	var records []any
+	for _, e := range machineErrors {
+		records = append(records, map[string]any{
+			"errorCode":  e.Code,
+			"error":      e.Text,
+			"errorShort": e.Short,
+			"origin":     origin{SerialNumber: e.serialNumber},
+			"timestamp":  map[string]int64{"milliseconds": e.Time.UnixMilli()},
+		})
+	}
+	writeJSON(w, paginate(records, body.Offset, body.Limit))
+}
+
+func (s *Simulator) serveHealth(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	machines, ok := s.requestedMachines(w, r)
+	if !ok {
+		return
+	}
+	details := []any{}
+	for _, machine := range machines {
+		details = append(details, map[string]any{
+			"id":           machine.ID,
+			"origin":       origin{SerialNumber: machine.SerialNumber},
+			"reason":       machine.Health.Reason,
+			"cause":        machine.Health.Cause,
+			"healthStatus": machine.Health.Status,
+		})
+	}
+	writeJSON(w, map[string]any{"machineKPIDetails": details})
+}
+
+// requestedMachines returns a copy of the machines of the group given by the groupid parameter.
+func (s *Simulator) requestedMachines(w http.ResponseWriter, r *http.Request) ([]Machine, bool) {
+	id, err := strconv.ParseUint(r.URL.Query().Get("groupid"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid groupid", http.StatusBadRequest)
+		return nil, false
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	group, exists := findGroup(s.fleet.Groups, uint(id))
+	if !exists {
+		http.NotFound(w, r)
+		return nil, false
+	}
+	machines := machinesOf(group)
+	for i := range machines {
+		machines[i].Errors = append([]Error(nil), machines[i].Errors...)
+	}
+	return machines, true
+}
+
+func findGroup(groups []Group, id uint) (Group, bool) {
+	for _, group := range groups {
+		if group.ID == id {
+			return group, true
+		}
+		if found, exists := findGroup(group.Children, id); exists {
+			return found, true
+		}
+	}
+	return Group{}, false
+}
+
+// machinesOf returns the machines of the group and all its children.
+func machinesOf(group Group) []Machine {
+	machines := append([]Machine(nil), group.Machines...)
+	for _, child := range group.Children {
+		machines = append(machines, machinesOf(child)...)
+	}
+	return machines
+}
+
+func writeJSON(w http.ResponseWriter, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}